@@ -0,0 +1,18 @@
+# All the SSH keys of the vault
+data "dvls_entries" "ssh_keys" {
+  vault_id = "00000000-0000-0000-0000-000000000000"
+  type     = "Credential"
+  subtype  = "PrivateKey"
+}
+
+# Entries of a folder whose name starts with "prod-" and tagged "rotate"
+data "dvls_entries" "filtered" {
+  vault_id   = "00000000-0000-0000-0000-000000000000"
+  folder     = "foo\\bar"
+  name_regex = "^prod-"
+  tags       = ["rotate"]
+}
+
+output "ssh_key_names" {
+  value = [for entry in data.dvls_entries.ssh_keys.entries : entry.name]
+}
@@ -1,3 +1,17 @@
-data "dvls_entry_website" "example" {
+# Lookup by ID
+data "dvls_entry_website" "by_id" {
   id = "00000000-0000-0000-0000-000000000000"
 }
+
+# Lookup by name
+data "dvls_entry_website" "by_name" {
+  vault_id = "00000000-0000-0000-0000-000000000000"
+  name     = "foo"
+}
+
+# Lookup by name in a specific folder
+data "dvls_entry_website" "by_name_in_folder" {
+  vault_id = "00000000-0000-0000-0000-000000000000"
+  name     = "foo"
+  folder   = "foo\\bar"
+}
@@ -0,0 +1,15 @@
+# Certificates of two vaults expiring in the next 30 days
+data "dvls_expiring_certificates" "next_month" {
+  vault_ids   = ["00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000001"]
+  within_days = 30
+}
+
+# Certificates expiring before the end of the year
+data "dvls_expiring_certificates" "this_year" {
+  vault_ids      = ["00000000-0000-0000-0000-000000000000"]
+  expires_before = "2026-12-31T23:59:59Z"
+}
+
+output "certificates_to_renew" {
+  value = { for certificate in data.dvls_expiring_certificates.next_month.certificates : certificate.id => certificate.expiration }
+}
@@ -0,0 +1,18 @@
+# Lookup by ID
+data "dvls_folder" "by_id" {
+  vault_id = "00000000-0000-0000-0000-000000000000"
+  id       = "00000000-0000-0000-0000-000000000000"
+}
+
+# Lookup by name
+data "dvls_folder" "by_name" {
+  vault_id = "00000000-0000-0000-0000-000000000000"
+  name     = "foo"
+}
+
+# Lookup by name in a specific parent folder
+data "dvls_folder" "by_name_in_folder" {
+  vault_id = "00000000-0000-0000-0000-000000000000"
+  name     = "bar"
+  folder   = "foo"
+}
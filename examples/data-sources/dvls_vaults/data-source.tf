@@ -0,0 +1,10 @@
+data "dvls_vaults" "example" {
+  name_regex     = "^team-"
+  visibility     = "private"
+  security_level = "standard"
+  content_type   = "credentials"
+}
+
+output "team_vault_ids" {
+  value = { for vault in data.dvls_vaults.example.vaults : vault.name => vault.id }
+}
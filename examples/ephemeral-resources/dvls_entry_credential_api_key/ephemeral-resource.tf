@@ -0,0 +1,18 @@
+# Lookup by ID
+ephemeral "dvls_entry_credential_api_key" "by_id" {
+  vault_id = "00000000-0000-0000-0000-000000000000"
+  id       = "00000000-0000-0000-0000-000000000000"
+}
+
+# Lookup by name
+ephemeral "dvls_entry_credential_api_key" "by_name" {
+  vault_id = "00000000-0000-0000-0000-000000000000"
+  name     = "foo"
+}
+
+# Lookup by name in a specific folder
+ephemeral "dvls_entry_credential_api_key" "by_name_in_folder" {
+  vault_id = "00000000-0000-0000-0000-000000000000"
+  name     = "foo"
+  folder   = "foo\\bar"
+}
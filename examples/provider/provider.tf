@@ -3,3 +3,27 @@ provider "dvls" {
   app_id     = "00000000-0000-0000-0000-000000000000"
   app_secret = "your-sensitive-secret"
 }
+
+# The settings can also be read from the DVLS_BASE_URI, DVLS_APP_ID and
+# DVLS_APP_SECRET environment variables, with an empty block:
+#
+# provider "dvls" {}
+#
+# The app secret can be read from a file, such as a mounted Kubernetes secret:
+#
+# provider "dvls" {
+#   base_uri        = "https://your-dvls-instance.com/"
+#   app_id          = "00000000-0000-0000-0000-000000000000"
+#   app_secret_file = "/var/run/secrets/dvls/app-secret"
+# }
+#
+# A pre-issued session token can be used instead of an application identity,
+# set in the auth block or with the DVLS_TOKEN environment variable:
+#
+# provider "dvls" {
+#   base_uri = "https://your-dvls-instance.com/"
+#
+#   auth {
+#     method = "token"
+#   }
+# }
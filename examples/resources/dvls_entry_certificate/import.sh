@@ -1 +1,5 @@
+# This resource can be imported using `<vault_id>/<entry_id>` format, e.g.
+terraform import dvls_entry_certificate.example 00000000-0000-0000-0000-000000000000/00000000-0000-0000-0000-000000000000
+
+# The entry ID alone is still accepted, e.g.
 terraform import dvls_entry_certificate.example 00000000-0000-0000-0000-000000000000
@@ -20,7 +20,6 @@ resource "dvls_entry_certificate" "file" {
   name        = "foo"
   folder      = "foo\\bar"
   description = "bar"
-  expiration  = "2022-12-31T23:59:59-05:00"
   tags        = ["foo", "bar"]
 
   password = "bar"
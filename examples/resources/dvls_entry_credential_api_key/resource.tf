@@ -9,3 +9,13 @@ resource "dvls_entry_credential_api_key" "example" {
   api_key   = "bar"
   tenant_id = "foo"
 }
+
+# Terraform 1.11+: the value is sent to DVLS but never stored in the plan or state.
+# Increment api_key_wo_version to push a new value.
+resource "dvls_entry_credential_api_key" "write_only" {
+  vault_id = "00000000-0000-0000-0000-000000000000"
+  name     = "foo"
+
+  api_key_wo         = "bar"
+  api_key_wo_version = 1
+}
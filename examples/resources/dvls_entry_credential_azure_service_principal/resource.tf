@@ -9,3 +9,13 @@ resource "dvls_entry_credential_azure_service_principal" "example" {
   client_secret = "bar"
   tenant_id     = "foo"
 }
+
+# Terraform 1.11+: the value is sent to DVLS but never stored in the plan or state.
+# Increment client_secret_wo_version to push a new value.
+resource "dvls_entry_credential_azure_service_principal" "write_only" {
+  vault_id = "00000000-0000-0000-0000-000000000000"
+  name     = "foo"
+
+  client_secret_wo         = "bar"
+  client_secret_wo_version = 1
+}
@@ -7,3 +7,13 @@ resource "dvls_entry_credential_connection_string" "example" {
 
   connection_string = "bar"
 }
+
+# Terraform 1.11+: the value is sent to DVLS but never stored in the plan or state.
+# Increment connection_string_wo_version to push a new value.
+resource "dvls_entry_credential_connection_string" "write_only" {
+  vault_id = "00000000-0000-0000-0000-000000000000"
+  name     = "foo"
+
+  connection_string_wo         = "bar"
+  connection_string_wo_version = 1
+}
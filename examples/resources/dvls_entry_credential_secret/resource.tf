@@ -7,3 +7,13 @@ resource "dvls_entry_credential_secret" "example" {
 
   secret = "bar"
 }
+
+# Terraform 1.11+: the value is sent to DVLS but never stored in the plan or state.
+# Increment secret_wo_version to push a new value.
+resource "dvls_entry_credential_secret" "write_only" {
+  vault_id = "00000000-0000-0000-0000-000000000000"
+  name     = "foo"
+
+  secret_wo         = "bar"
+  secret_wo_version = 1
+}
@@ -11,3 +11,14 @@ resource "dvls_entry_credential_ssh_key" "example" {
   private_key_data = "foo"
   public_key       = "bar"
 }
+
+# Terraform 1.11+: the values are sent to DVLS but never stored in the plan or state.
+# Increment the matching *_wo_version to push a new value.
+resource "dvls_entry_credential_ssh_key" "write_only" {
+  vault_id = "00000000-0000-0000-0000-000000000000"
+  name     = "foo"
+
+  username                    = "foo"
+  private_key_data_wo         = "foo"
+  private_key_data_wo_version = 1
+}
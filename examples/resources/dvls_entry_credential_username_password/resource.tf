@@ -9,3 +9,13 @@ resource "dvls_entry_credential_username_password" "example" {
   domain   = "foo.bar"
   password = "bar"
 }
+
+# Terraform 1.11+: the value is sent to DVLS but never stored in the plan or state.
+# Increment password_wo_version to push a new value.
+resource "dvls_entry_credential_username_password" "write_only" {
+  vault_id = "00000000-0000-0000-0000-000000000000"
+  name     = "foo"
+
+  password_wo         = "bar"
+  password_wo_version = 1
+}
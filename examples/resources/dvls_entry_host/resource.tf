@@ -0,0 +1,11 @@
+resource "dvls_entry_host" "example" {
+  vault_id    = "00000000-0000-0000-0000-000000000000"
+  name        = "foo"
+  folder      = "foo\\bar"
+  description = "bar"
+  tags        = ["foo"]
+
+  host     = "foo.example.com"
+  username = "foo"
+  password = "bar"
+}
@@ -0,0 +1,2 @@
+# This resource can be imported using `<vault_id>/<entry_id>` format, e.g.
+terraform import dvls_entry_website.example 00000000-0000-0000-0000-000000000000/00000000-0000-0000-0000-000000000000
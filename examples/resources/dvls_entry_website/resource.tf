@@ -0,0 +1,12 @@
+resource "dvls_entry_website" "example" {
+  vault_id    = "00000000-0000-0000-0000-000000000000"
+  name        = "foo"
+  folder      = "foo\\bar"
+  description = "bar"
+  tags        = ["foo"]
+
+  url                     = "https://foo.example.com"
+  web_browser_application = "google_chrome"
+  username                = "foo"
+  password                = "bar"
+}
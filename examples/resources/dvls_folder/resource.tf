@@ -0,0 +1,20 @@
+resource "dvls_folder" "parent" {
+  vault_id    = "00000000-0000-0000-0000-000000000000"
+  name        = "foo"
+  description = "bar"
+  tags        = ["foo"]
+}
+
+resource "dvls_folder" "child" {
+  vault_id = "00000000-0000-0000-0000-000000000000"
+  name     = "bar"
+  folder   = dvls_folder.parent.path
+}
+
+# Entries use the folder path, which also makes them depend on the folder.
+resource "dvls_entry_credential_secret" "example" {
+  vault_id = "00000000-0000-0000-0000-000000000000"
+  name     = "foo"
+  folder   = dvls_folder.child.path
+  secret   = "bar"
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	authMethodApplication = "application"
+	authMethodToken       = "token"
+)
+
+var authMethods = []string{authMethodApplication, authMethodToken}
+
+const (
+	loginEndpoint    = "/api/v1/login"
+	isLoggedEndpoint = "/api/is-logged"
+)
+
+// DvlsProviderAuthModel describes the auth block of the provider.
+type DvlsProviderAuthModel struct {
+	Method types.String `tfsdk:"method"`
+	Token  types.String `tfsdk:"token"`
+}
+
+// tokenLoginTransport authenticates go-dvls with a pre-issued session token.
+// go-dvls can only log in with an application identity, so the transport
+// answers its login requests with the token instead of sending them to DVLS;
+// go-dvls then sends the token with every request.
+type tokenLoginTransport struct {
+	base  http.RoundTripper
+	token string
+}
+
+func newTokenLoginTransport(base http.RoundTripper, token string) *tokenLoginTransport {
+	return &tokenLoginTransport{
+		base:  base,
+		token: token,
+	}
+}
+
+func (t *tokenLoginTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodPost || !strings.HasSuffix(strings.TrimRight(req.URL.Path, "/"), loginEndpoint) {
+		return t.base.RoundTrip(req)
+	}
+
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	body, err := json.Marshal(map[string]string{"TokenId": t.token})
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// verifyToken returns an error if DVLS does not accept the session token used
+// by the client.
+func verifyToken(ctx context.Context, client *dvls.Client, baseUri string) error {
+	reqUrl, err := url.JoinPath(baseUri, isLoggedEndpoint)
+	if err != nil {
+		return fmt.Errorf("failed to build is-logged url: %w", err)
+	}
+
+	resp, err := client.RequestWithContext(ctx, reqUrl, http.MethodGet, nil, dvls.RequestOptions{RawBody: true})
+	if err != nil {
+		return fmt.Errorf("error while verifying the token: %w", err)
+	}
+
+	if strings.TrimSpace(string(resp.Response)) == "false" {
+		return fmt.Errorf("the token is invalid or expired")
+	}
+
+	return nil
+}
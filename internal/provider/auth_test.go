@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTokenLoginTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case loginEndpoint:
+			t.Error("expected the login request not to be sent to DVLS")
+			w.WriteHeader(http.StatusUnauthorized)
+		case isLoggedEndpoint:
+			if r.Header.Get("tokenId") == "valid-token" {
+				w.Write([]byte("true"))
+			} else {
+				w.Write([]byte("false"))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Cleanup(func() { http.DefaultTransport = defaultTransport })
+
+	for token, valid := range map[string]bool{"valid-token": true, "expired-token": false} {
+		http.DefaultTransport = newTokenLoginTransport(defaultTransport, token)
+
+		client, err := dvls.NewClient("", "", server.URL)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", token, err)
+		}
+
+		err = verifyToken(context.Background(), &client, server.URL)
+		if valid && err != nil {
+			t.Errorf("%s: expected the token to be accepted, got %s", token, err)
+		}
+
+		if !valid && err == nil {
+			t.Errorf("%s: expected the token to be rejected", token)
+		}
+	}
+}
+
+func TestDvlsProvider_ConfigureAuth(t *testing.T) {
+	t.Setenv("DVLS_BASE_URI", "https://dvls.example.com")
+	t.Setenv("DVLS_APP_ID", "")
+	t.Setenv("DVLS_APP_SECRET", "")
+	t.Setenv("DVLS_TOKEN", "")
+
+	p := New("test")()
+
+	authType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"method": tftypes.String, "token": tftypes.String}}
+	auth := func(method string) tftypes.Value {
+		return tftypes.NewValue(authType, map[string]tftypes.Value{
+			"method": tftypes.NewValue(tftypes.String, method),
+			"token":  tftypes.NewValue(tftypes.String, nil),
+		})
+	}
+
+	emptySecretFile := filepath.Join(t.TempDir(), "app_secret")
+	if err := os.WriteFile(emptySecretFile, []byte("\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		values map[string]tftypes.Value
+		errors []path.Path
+	}{
+		"token method without token": {
+			values: map[string]tftypes.Value{"auth": auth(authMethodToken)},
+			errors: []path.Path{path.Root("auth").AtName("token")},
+		},
+		"token method with app id": {
+			values: map[string]tftypes.Value{
+				"auth":   auth(authMethodToken),
+				"app_id": tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000000"),
+			},
+			errors: []path.Path{path.Root("auth").AtName("token"), path.Root("app_id")},
+		},
+		"missing app secret file": {
+			values: map[string]tftypes.Value{
+				"app_id":          tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000000"),
+				"app_secret_file": tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "missing")),
+			},
+			errors: []path.Path{path.Root("app_secret_file")},
+		},
+		"empty app secret file": {
+			values: map[string]tftypes.Value{
+				"app_id":          tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000000"),
+				"app_secret_file": tftypes.NewValue(tftypes.String, emptySecretFile),
+			},
+			errors: []path.Path{path.Root("app_secret_file")},
+		},
+	}
+
+	for name, testCase := range testCases {
+		var resp provider.ConfigureResponse
+		p.Configure(context.Background(), testProviderConfigureRequest(t, p, testCase.values), &resp)
+
+		for _, attributePath := range testCase.errors {
+			if !testHasAttributeError(resp.Diagnostics, attributePath) {
+				t.Errorf("%s: expected an error on %s, got %v", name, attributePath, resp.Diagnostics)
+			}
+		}
+
+		if len(resp.Diagnostics.Errors()) != len(testCase.errors) {
+			t.Errorf("%s: expected %d errors, got %v", name, len(testCase.errors), resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// entryListEndpoint lists the entries of a vault.
+const entryListEndpoint = "/api/v1/vault/{vaultId}/entry"
+
+// entryPartialEndpoint is the legacy entry endpoint used to save the entry
+// types that go-dvls can only read (hosts, websites).
+const entryPartialEndpoint = "/api/connections/partial"
+
+// attachmentEndpoint is the legacy endpoint used to upload the document stored
+// in an entry.
+const attachmentEndpoint = "/api/attachment"
+
+// dvlsClient is the data shared with every resource and data source. It embeds
+// the go-dvls client and keeps the provider settings that the client does not
+// expose.
+type dvlsClient struct {
+	*dvls.Client
+
+	baseUri string
+
+	certificateExpiry certificateExpiry
+}
+
+// configured reports whether the provider has created the DVLS client, which
+// it does not do while its configuration has unknown values.
+func (c *dvlsClient) configured() bool {
+	return c != nil && c.Client != nil
+}
+
+// addProviderNotConfiguredError reports that an operation needs the DVLS client
+// while the provider configuration is still unknown.
+func addProviderNotConfiguredError(diags *diag.Diagnostics) {
+	diags.AddError(
+		"provider not yet configured",
+		"The DVLS provider configuration depends on values that are not known yet, such as the attributes of a resource that has not been created. "+
+			"Apply the resources it depends on first (e.g. with -target) or run Terraform with deferred actions enabled.",
+	)
+}
+
+// saveEntry creates (POST) or updates (PUT) a legacy entry and returns the ID
+// of the saved entry.
+func (c *dvlsClient) saveEntry(ctx context.Context, method string, entry any) (string, error) {
+	reqUrl, err := url.JoinPath(c.baseUri, entryPartialEndpoint, "save")
+	if err != nil {
+		return "", fmt.Errorf("failed to build entry url: %w", err)
+	}
+
+	entryJson, err := json.Marshal(entry)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal body: %w", err)
+	}
+
+	resp, err := c.RequestWithContext(ctx, reqUrl, method, bytes.NewBuffer(entryJson))
+	if err != nil {
+		return "", fmt.Errorf("error while saving entry: %w", err)
+	} else if err = resp.CheckRespSaveResult(); err != nil {
+		return "", err
+	}
+
+	var saved struct {
+		Id string `json:"id"`
+	}
+
+	if err := unmarshalLegacyEntryData(resp.Response, &saved); err != nil {
+		return "", err
+	}
+
+	return saved.Id, nil
+}
+
+// uploadEntryDocument uploads the content of the document stored in a legacy
+// entry, the same way go-dvls does when it creates a certificate entry from a
+// file.
+func (c *dvlsClient) uploadEntryDocument(ctx context.Context, entryId, fileName string, content []byte) error {
+	reqUrl, err := url.JoinPath(c.baseUri, attachmentEndpoint, "save")
+	if err != nil {
+		return fmt.Errorf("failed to build attachment url: %w", err)
+	}
+
+	attachmentJson, err := json.Marshal(dvls.EntryAttachment{
+		EntryId:   entryId,
+		FileName:  fileName,
+		Size:      len(content),
+		IsPrivate: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal body: %w", err)
+	}
+
+	resp, err := c.RequestWithContext(ctx, reqUrl+"?private=false&useSensitiveMode=true", http.MethodPost, bytes.NewBuffer(attachmentJson))
+	if err != nil {
+		return fmt.Errorf("error while submitting entry attachment request: %w", err)
+	} else if err = resp.CheckRespSaveResult(); err != nil {
+		return err
+	}
+
+	var attachment dvls.EntryAttachment
+	if err := json.Unmarshal(resp.Response, &attachment); err != nil {
+		return fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	reqUrl, err = url.JoinPath(c.baseUri, attachmentEndpoint, attachment.Id, "document")
+	if err != nil {
+		return fmt.Errorf("failed to build attachment url: %w", err)
+	}
+
+	resp, err = c.RequestWithContext(ctx, reqUrl, http.MethodPost, bytes.NewBuffer(content), dvls.RequestOptions{ContentType: http.DetectContentType(content)})
+	if err != nil {
+		return fmt.Errorf("error while uploading entry attachment: %w", err)
+	} else if err = resp.CheckRespSaveResult(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// deleteEntry deletes a legacy entry.
+func (c *dvlsClient) deleteEntry(ctx context.Context, entryId string) error {
+	reqUrl, err := url.JoinPath(c.baseUri, entryPartialEndpoint, entryId)
+	if err != nil {
+		return fmt.Errorf("failed to build entry url: %w", err)
+	}
+
+	resp, err := c.RequestWithContext(ctx, reqUrl, http.MethodDelete, nil)
+	if err != nil {
+		return fmt.Errorf("error while deleting entry: %w", err)
+	} else if err = resp.CheckRespSaveResult(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// entrySummary is an entry as returned by the entry list endpoint. Unlike
+// dvls.Entry, it can hold entries of any type.
+type entrySummary struct {
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	Path        string   `json:"path"`
+	Type        string   `json:"type"`
+	SubType     string   `json:"subType"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+}
+
+// listEntries returns the entries of a vault with optional filters. Unlike
+// the go-dvls entry list, entries of the types it does not support are
+// included. When opts.Path is set, only the entries in this folder and its
+// sub-folders are returned.
+func (c *dvlsClient) listEntries(ctx context.Context, vaultId string, opts dvls.GetEntriesOptions) ([]entrySummary, error) {
+	reqUrl, err := url.JoinPath(c.baseUri, strings.ReplaceAll(entryListEndpoint, "{vaultId}", vaultId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build entry url: %w", err)
+	}
+
+	parsedUrl, err := url.Parse(reqUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse entry url: %w", err)
+	}
+
+	var entries []entrySummary
+	for page := 1; ; page++ {
+		q := parsedUrl.Query()
+		if opts.Name != nil {
+			q.Set("name", *opts.Name)
+		}
+		if opts.Path != nil && *opts.Path != "" {
+			q.Set("path", *opts.Path)
+		}
+		q.Set("page", fmt.Sprintf("%d", page))
+		parsedUrl.RawQuery = q.Encode()
+
+		resp, err := c.RequestWithContext(ctx, parsedUrl.String(), http.MethodGet, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error while fetching entries (page %d): %w", page, err)
+		}
+
+		var respData struct {
+			Data      []entrySummary `json:"data"`
+			TotalPage int            `json:"totalPage"`
+		}
+
+		if err := json.Unmarshal(resp.Response, &respData); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal entry list response (page %d): %w", page, err)
+		}
+
+		entries = append(entries, respData.Data...)
+
+		if page >= respData.TotalPage {
+			break
+		}
+	}
+
+	// The server path filter is not exact, so the entries are filtered again
+	// on their path.
+	if opts.Path != nil {
+		var filtered []entrySummary
+		for _, entry := range entries {
+			if entry.Path == *opts.Path || (*opts.Path != "" && strings.HasPrefix(entry.Path, *opts.Path+"\\")) {
+				filtered = append(filtered, entry)
+			}
+		}
+
+		return filtered, nil
+	}
+
+	return entries, nil
+}
+
+// legacyEntryType is the type information of an entry fetched through the
+// legacy endpoints.
+type legacyEntryType struct {
+	VaultId           string                       `json:"repositoryId"`
+	ConnectionType    dvls.ServerConnectionType    `json:"connectionType"`
+	ConnectionSubType dvls.ServerConnectionSubType `json:"connectionSubType"`
+}
+
+// getLegacyEntryType returns the type information of the entry specified by
+// entryId.
+func (c *dvlsClient) getLegacyEntryType(ctx context.Context, entryId string) (legacyEntryType, error) {
+	var entryType legacyEntryType
+	if err := c.getLegacyEntry(ctx, entryId, &entryType); err != nil {
+		return legacyEntryType{}, err
+	}
+
+	return entryType, nil
+}
+
+// getLegacyEntry decodes the entry specified by entryId, as returned by the
+// legacy endpoints, into v.
+func (c *dvlsClient) getLegacyEntry(ctx context.Context, entryId string, v any) error {
+	reqUrl, err := url.JoinPath(c.baseUri, entryPartialEndpoint, entryId)
+	if err != nil {
+		return fmt.Errorf("failed to build entry url: %w", err)
+	}
+
+	resp, err := c.RequestWithContext(ctx, reqUrl, http.MethodGet, nil)
+	if err != nil {
+		return fmt.Errorf("error while fetching entry: %w", err)
+	} else if err = resp.CheckRespSaveResult(); err != nil {
+		return err
+	}
+
+	return unmarshalLegacyEntryData(resp.Response, v)
+}
+
+// findLegacyEntryId returns the ID of the only entry named name in the vault
+// with the given connection type. An empty connectionSubType matches any
+// subtype. When folder is set, only the entries in this folder and its
+// sub-folders are considered.
+// Returns dvls.ErrEntryNotFound if no entry matches and
+// dvls.ErrMultipleEntriesFound if more than one entry matches.
+func (c *dvlsClient) findLegacyEntryId(ctx context.Context, vaultId, name string, folder *string, connectionType dvls.ServerConnectionType, connectionSubType dvls.ServerConnectionSubType) (string, error) {
+	entries, err := c.listEntries(ctx, vaultId, dvls.GetEntriesOptions{Name: &name, Path: folder})
+	if err != nil {
+		return "", err
+	}
+
+	var matches []string
+	for _, entry := range entries {
+		if entry.Name != name {
+			continue
+		}
+
+		entryType, err := c.getLegacyEntryType(ctx, entry.Id)
+		if err != nil {
+			return "", err
+		}
+
+		if entryType.ConnectionType != connectionType {
+			continue
+		}
+
+		if connectionSubType != "" && entryType.ConnectionSubType != connectionSubType {
+			continue
+		}
+
+		matches = append(matches, entry.Id)
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", dvls.ErrEntryNotFound
+	case 1:
+		return matches[0], nil
+	default:
+		return "", dvls.ErrMultipleEntriesFound
+	}
+}
+
+// unmarshalLegacyEntryData decodes the entry returned in the data field of a
+// legacy endpoint response. Depending on the DVLS version, the entry is
+// returned either as an object or as a JSON encoded string.
+func unmarshalLegacyEntryData(body []byte, v any) error {
+	var respData struct {
+		Data json.RawMessage `json:"data"`
+	}
+
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	data := []byte(respData.Data)
+	var dataString string
+	if err := json.Unmarshal(data, &dataString); err == nil {
+		data = []byte(dataString)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to unmarshal entry: %w", err)
+	}
+
+	return nil
+}
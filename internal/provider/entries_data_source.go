@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EntriesDataSource{}
+
+func NewEntriesDataSource() datasource.DataSource {
+	return &EntriesDataSource{}
+}
+
+// EntriesDataSource defines the data source implementation.
+type EntriesDataSource struct {
+	client *dvlsClient
+}
+
+// EntriesDataSourceModel describes the data source data model.
+type EntriesDataSourceModel struct {
+	VaultId   types.String   `tfsdk:"vault_id"`
+	Type      types.String   `tfsdk:"type"`
+	SubType   types.String   `tfsdk:"subtype"`
+	Folder    types.String   `tfsdk:"folder"`
+	NameRegex types.String   `tfsdk:"name_regex"`
+	Tags      []types.String `tfsdk:"tags"`
+
+	Entries []EntriesDataSourceEntryModel `tfsdk:"entries"`
+}
+
+// EntriesDataSourceEntryModel describes an entry returned by the data source.
+type EntriesDataSourceEntryModel struct {
+	Id          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Folder      types.String   `tfsdk:"folder"`
+	Type        types.String   `tfsdk:"type"`
+	SubType     types.String   `tfsdk:"subtype"`
+	Description types.String   `tfsdk:"description"`
+	Tags        []types.String `tfsdk:"tags"`
+}
+
+func (d *EntriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entries"
+}
+
+func (d *EntriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the entries of a DVLS vault. Secret values are never returned.",
+
+		Attributes: map[string]schema.Attribute{
+			"vault_id": schema.StringAttribute{
+				Description: "The ID of the vault.",
+				Required:    true,
+				Validators:  []validator.String{vaultIdValidator{}},
+			},
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf("Only return the entries of this type (e.g. `%s`).", dvls.EntryCredentialType),
+				Optional:    true,
+			},
+			"subtype": schema.StringAttribute{
+				Description: fmt.Sprintf("Only return the entries of this subtype (e.g. `%s`).", dvls.EntryCredentialSubTypePrivateKey),
+				Optional:    true,
+			},
+			"folder": schema.StringAttribute{
+				Description: "Only return the entries in this folder and all its sub-folders.",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return the entries whose name matches this regular expression.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Only return the entries that have all of these tags.",
+				Optional:    true,
+			},
+			"entries": schema.ListNestedAttribute{
+				Description: "The entries matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the entry.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the entry.",
+							Computed:    true,
+						},
+						"folder": schema.StringAttribute{
+							Description: "The folder path of the entry.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the entry.",
+							Computed:    true,
+						},
+						"subtype": schema.StringAttribute{
+							Description: "The subtype of the entry.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the entry.",
+							Computed:    true,
+						},
+						"tags": schema.ListAttribute{
+							ElementType: types.StringType,
+							Description: "The tags of the entry.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EntriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dvlsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.dvlsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EntriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	var data *EntriesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "invalid name regular expression", err.Error())
+			return
+		}
+	}
+
+	var opts dvls.GetEntriesOptions
+	if !data.Folder.IsNull() {
+		opts.Path = data.Folder.ValueStringPointer()
+	}
+
+	entries, err := d.client.listEntries(ctx, data.VaultId.ValueString(), opts)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to list entries", err)
+		return
+	}
+
+	data.Entries = []EntriesDataSourceEntryModel{}
+
+	for _, entry := range entries {
+		if !data.Type.IsNull() && entry.Type != data.Type.ValueString() {
+			continue
+		}
+
+		if !data.SubType.IsNull() && entry.SubType != data.SubType.ValueString() {
+			continue
+		}
+
+		if nameRegex != nil && !nameRegex.MatchString(entry.Name) {
+			continue
+		}
+
+		if !entryHasTags(entry, data.Tags) {
+			continue
+		}
+
+		data.Entries = append(data.Entries, newEntriesDataSourceEntryModel(entry))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// entryHasTags reports whether entry has all of the given tags.
+func entryHasTags(entry entrySummary, tags []types.String) bool {
+	for _, tag := range tags {
+		if !slices.Contains(entry.Tags, tag.ValueString()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func newEntriesDataSourceEntryModel(entry entrySummary) EntriesDataSourceEntryModel {
+	model := EntriesDataSourceEntryModel{
+		Id:          basetypes.NewStringValue(entry.Id),
+		Name:        basetypes.NewStringValue(entry.Name),
+		Folder:      basetypes.NewStringValue(entry.Path),
+		Type:        basetypes.NewStringValue(entry.Type),
+		SubType:     basetypes.NewStringValue(entry.SubType),
+		Description: basetypes.NewStringValue(entry.Description),
+		Tags:        []types.String{},
+	}
+
+	for _, v := range entry.Tags {
+		model.Tags = append(model.Tags, basetypes.NewStringValue(v))
+	}
+
+	return model
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEntriesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEntryCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntriesDataSourceConfig("tf_test_entries"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dvls_entries.all", "entries.#", "3"),
+
+					resource.TestCheckResourceAttr("data.dvls_entries.by_subtype", "entries.#", "1"),
+					resource.TestCheckResourceAttrPair("data.dvls_entries.by_subtype", "entries.0.id", "dvls_entry_credential_ssh_key.test", "id"),
+					resource.TestCheckResourceAttr("data.dvls_entries.by_subtype", "entries.0.type", "Credential"),
+					resource.TestCheckResourceAttr("data.dvls_entries.by_subtype", "entries.0.subtype", "PrivateKey"),
+
+					resource.TestCheckResourceAttr("data.dvls_entries.by_folder", "entries.#", "2"),
+
+					resource.TestCheckResourceAttr("data.dvls_entries.by_name_regex", "entries.#", "1"),
+					resource.TestCheckResourceAttrPair("data.dvls_entries.by_name_regex", "entries.0.id", "dvls_entry_credential_secret.first", "id"),
+					resource.TestCheckResourceAttr("data.dvls_entries.by_name_regex", "entries.0.name", "tf_test_secret_first"),
+					resource.TestCheckResourceAttr("data.dvls_entries.by_name_regex", "entries.0.folder", "tf_test_folder"),
+					resource.TestCheckResourceAttr("data.dvls_entries.by_name_regex", "entries.0.description", "first secret"),
+
+					resource.TestCheckResourceAttr("data.dvls_entries.by_tags", "entries.#", "1"),
+					resource.TestCheckResourceAttrPair("data.dvls_entries.by_tags", "entries.0.id", "dvls_entry_credential_secret.second", "id"),
+					resource.TestCheckResourceAttr("data.dvls_entries.by_tags", "entries.0.tags.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEntriesDataSourceConfig(vaultName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "dvls_vault" "test" {
+  name = %[2]q
+}
+
+resource "dvls_entry_credential_secret" "first" {
+  vault_id    = dvls_vault.test.id
+  name        = "tf_test_secret_first"
+  description = "first secret"
+  folder      = "tf_test_folder"
+  tags        = ["tf-test"]
+  secret      = "my-secret-value-123"
+}
+
+resource "dvls_entry_credential_secret" "second" {
+  vault_id = dvls_vault.test.id
+  name     = "tf_test_secret_second"
+  folder   = "tf_test_folder\\sub"
+  tags     = ["tf-test", "rotate"]
+  secret   = "my-secret-value-456"
+}
+
+resource "dvls_entry_credential_ssh_key" "test" {
+  vault_id         = dvls_vault.test.id
+  name             = "tf_test_ssh_key"
+  private_key_data = "my-private-key"
+}
+
+data "dvls_entries" "all" {
+  vault_id = dvls_vault.test.id
+
+  depends_on = [
+    dvls_entry_credential_secret.first,
+    dvls_entry_credential_secret.second,
+    dvls_entry_credential_ssh_key.test,
+  ]
+}
+
+data "dvls_entries" "by_subtype" {
+  vault_id = dvls_vault.test.id
+  subtype  = "PrivateKey"
+
+  depends_on = [dvls_entry_credential_ssh_key.test]
+}
+
+data "dvls_entries" "by_folder" {
+  vault_id = dvls_vault.test.id
+  folder   = "tf_test_folder"
+
+  depends_on = [
+    dvls_entry_credential_secret.first,
+    dvls_entry_credential_secret.second,
+  ]
+}
+
+data "dvls_entries" "by_name_regex" {
+  vault_id   = dvls_vault.test.id
+  name_regex = "^tf_test_secret_f"
+
+  depends_on = [dvls_entry_credential_secret.first]
+}
+
+data "dvls_entries" "by_tags" {
+  vault_id = dvls_vault.test.id
+  tags     = ["rotate"]
+
+  depends_on = [dvls_entry_credential_secret.second]
+}
+`, testAccProviderConfig(), vaultName)
+}
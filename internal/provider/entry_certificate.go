@@ -3,12 +3,15 @@ package provider
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/Devolutions/go-dvls"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -58,6 +61,9 @@ func setEntryCertificateResourceModel(ctx context.Context, entrycertificate dvls
 		Expiration: timeVal,
 		File:       basetypes.NewObjectNull(EntryCertificateResourceModelFile{}.AttributeTypes()),
 		Url:        basetypes.NewObjectNull(EntryCertificateResourceModelUrl{}.AttributeTypes()),
+
+		EntryCertificateMetadataModel: newEntryCertificateMetadataNull(),
+		EntryCertificateExpiryModel:   data.EntryCertificateExpiryModel,
 	}
 
 	if entrycertificate.EntryFolderPath != "" {
@@ -96,6 +102,9 @@ func setEntryCertificateResourceModel(ctx context.Context, entrycertificate dvls
 		}
 
 		model.File = objectValue
+
+		// The metadata stays null when the file is not a certificate that can be read.
+		model.EntryCertificateMetadataModel, _ = readCertificateMetadata(content, entrycertificate.Password)
 	case dvls.EntryCertificateDataModeURL:
 		urlObject := EntryCertificateResourceModelUrl{
 			Url:                   basetypes.NewStringValue(entrycertificate.CertificateIdentifier),
@@ -133,6 +142,9 @@ func setEntryCertificateDataModel(ctx context.Context, entrycertificate dvls.Ent
 		Expiration: timeVal,
 		Url:        basetypes.NewObjectNull(EntryCertificateResourceModelUrl{}.AttributeTypes()),
 		File:       basetypes.NewObjectNull(EntryCertificateResourceModelFile{}.AttributeTypes()),
+
+		EntryCertificateMetadataModel: newEntryCertificateMetadataNull(),
+		EntryCertificateExpiryModel:   data.EntryCertificateExpiryModel,
 	}
 
 	if entrycertificate.EntryFolderPath != "" {
@@ -171,6 +183,9 @@ func setEntryCertificateDataModel(ctx context.Context, entrycertificate dvls.Ent
 		}
 
 		model.File = objectValue
+
+		// The metadata stays null when the file is not a certificate that can be read.
+		model.EntryCertificateMetadataModel, _ = readCertificateMetadata(content, entrycertificate.Password)
 	case dvls.EntryCertificateDataModeURL:
 		urlObject := EntryCertificateResourceModelUrl{
 			Url:                   basetypes.NewStringValue(entrycertificate.CertificateIdentifier),
@@ -225,28 +240,97 @@ func getPlans(ctx context.Context, plan planInterface) (EntryCertificateResource
 	}, diags
 }
 
-func updateCertificateContent(plans EntryCertificateResourceModelData, client *dvls.Client, entrycertificate dvls.EntryCertificate, diags *diag.Diagnostics) dvls.EntryCertificate {
-	var err error
+// certificateContent returns the content of the file of the plan, or nil when
+// the certificate is stored as a url.
+func certificateContent(plans EntryCertificateResourceModelData) ([]byte, error) {
+	if plans.Data.File.IsNull() {
+		return nil, nil
+	}
 
-	if !plans.Data.File.IsNull() {
-		content, err := base64.StdEncoding.DecodeString(plans.File.ContentB64.ValueString())
-		if err != nil {
-			diags.AddError("unable to update certificate entry", err.Error())
-			return dvls.EntryCertificate{}
-		}
+	content, err := base64.StdEncoding.DecodeString(plans.File.ContentB64.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("file.content_b64 is not valid base 64: %w", err)
+	}
 
-		entrycertificate, err = client.Entries.Certificate.NewFile(entrycertificate, content)
-		if err != nil {
-			diags.AddError("unable to update certificate entry", err.Error())
-			return dvls.EntryCertificate{}
-		}
+	return content, nil
+}
+
+func createCertificateEntry(ctx context.Context, plans EntryCertificateResourceModelData, client *dvlsClient, entrycertificate dvls.EntryCertificate, diags *diag.Diagnostics) dvls.EntryCertificate {
+	content, err := certificateContent(plans)
+	if err != nil {
+		diags.AddAttributeError(path.Root("file").AtName("content_b64"), "unable to create certificate entry", err.Error())
+		return dvls.EntryCertificate{}
+	}
+
+	if content != nil {
+		entrycertificate, err = client.Entries.Certificate.NewFileWithContext(ctx, entrycertificate, content)
 	} else {
-		entrycertificate, err = client.Entries.Certificate.NewURL(entrycertificate)
-		if err != nil {
-			diags.AddError("unable to update certificate entry", err.Error())
-			return dvls.EntryCertificate{}
-		}
+		entrycertificate, err = client.Entries.Certificate.NewURLWithContext(ctx, entrycertificate)
+	}
+	if err != nil {
+		addDvlsError(diags, "unable to create certificate entry", err)
+		return dvls.EntryCertificate{}
 	}
 
 	return entrycertificate
 }
+
+// saveCertificateEntryContent updates a certificate entry along with its file
+// or url. go-dvls keeps the data mode and the document of the entry when it
+// updates a certificate, so the entry is saved with the data mode of the new
+// content through the legacy endpoint, then the file is uploaded again.
+func saveCertificateEntryContent(ctx context.Context, client *dvlsClient, entrycertificate dvls.EntryCertificate, content []byte) error {
+	entryJson, err := json.Marshal(entrycertificate)
+	if err != nil {
+		return fmt.Errorf("failed to marshal body: %w", err)
+	}
+
+	var entry map[string]any
+	if err := json.Unmarshal(entryJson, &entry); err != nil {
+		return fmt.Errorf("failed to unmarshal body: %w", err)
+	}
+
+	data, ok := entry["data"].(map[string]any)
+	if !ok {
+		return fmt.Errorf("unexpected certificate entry body")
+	}
+
+	data["dataMode"] = dvls.EntryCertificateDataModeURL
+	data["documentSize"] = 0
+
+	if content != nil {
+		data["dataMode"] = dvls.EntryCertificateDataModeFile
+		data["documentSize"] = len(content)
+	}
+
+	if _, err := client.saveEntry(ctx, http.MethodPut, entry); err != nil {
+		return err
+	}
+
+	if content == nil {
+		return nil
+	}
+
+	return client.uploadEntryDocument(ctx, entrycertificate.Id, entrycertificate.CertificateIdentifier, content)
+}
+
+// readCertificateEntry returns the certificate entry as stored in DVLS, with
+// its password and the content of its file.
+func readCertificateEntry(ctx context.Context, client *dvlsClient, entryId string) (dvls.EntryCertificate, []byte, error) {
+	entrycertificate, err := client.Entries.Certificate.GetWithContext(ctx, entryId)
+	if err != nil {
+		return dvls.EntryCertificate{}, nil, err
+	}
+
+	entrycertificate, err = client.Entries.Certificate.GetPasswordWithContext(ctx, entrycertificate)
+	if err != nil {
+		return dvls.EntryCertificate{}, nil, err
+	}
+
+	content, err := client.Entries.Certificate.GetFileContentWithContext(ctx, entrycertificate.Id)
+	if err != nil {
+		return dvls.EntryCertificate{}, nil, err
+	}
+
+	return entrycertificate, content, nil
+}
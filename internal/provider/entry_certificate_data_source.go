@@ -2,20 +2,26 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
+	"time"
 
 	"github.com/Devolutions/go-dvls"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &EntryCertificateDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &EntryCertificateDataSource{}
 
 func NewEntryCertificateDataSource() datasource.DataSource {
 	return &EntryCertificateDataSource{}
@@ -23,7 +29,7 @@ func NewEntryCertificateDataSource() datasource.DataSource {
 
 // EntryCertificateDataSource defines the data source implementation.
 type EntryCertificateDataSource struct {
-	client *dvls.Client
+	client *dvlsClient
 }
 
 // EntryCertificateDataSourceModel describes the data source data model.
@@ -40,6 +46,12 @@ type EntryCertificateDataSourceModel struct {
 	Password types.String `tfsdk:"password"`
 	File     types.Object `tfsdk:"file"`
 	Url      types.Object `tfsdk:"url"`
+
+	// Read from the certificate file
+	EntryCertificateMetadataModel
+
+	// Expiration reporting
+	EntryCertificateExpiryModel
 }
 
 type EntryCertificateDataSourceModelData struct {
@@ -82,21 +94,28 @@ func (d *EntryCertificateDataSource) Schema(ctx context.Context, req datasource.
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Certificate ID",
-				Required:    true,
+				Description: "Certificate ID. Either id or name must be specified.",
+				Optional:    true,
+				Computed:    true,
 				Validators:  []validator.String{entryIdValidator{}},
 			},
 			"vault_id": schema.StringAttribute{
-				Description: "Vault ID",
+				Description: "Vault ID. Required when looking up the certificate by name.",
+				Optional:    true,
 				Computed:    true,
+				Validators:  []validator.String{vaultIdValidator{}},
 			},
 			"name": schema.StringAttribute{
-				Description: "Certificate name",
+				Description: "Certificate name. Either id or name must be specified.",
+				Optional:    true,
 				Computed:    true,
+				Validators:  []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("vault_id"))},
 			},
 			"folder": schema.StringAttribute{
-				Description: "Certificate folder path",
+				Description: "Certificate folder path. When looking up the certificate by name, returns entries in the specified folder and all sub-folders.",
+				Optional:    true,
 				Computed:    true,
+				Validators:  []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("name"))},
 			},
 			"description": schema.StringAttribute{
 				Description: "Certificate description",
@@ -113,6 +132,52 @@ func (d *EntryCertificateDataSource) Schema(ctx context.Context, req datasource.
 				Computed:    true,
 			},
 
+			"subject": schema.StringAttribute{
+				Description: "Subject of the certificate, read from the file.",
+				Computed:    true,
+			},
+			"issuer": schema.StringAttribute{
+				Description: "Issuer of the certificate, read from the file.",
+				Computed:    true,
+			},
+			"serial_number": schema.StringAttribute{
+				Description: "Serial number of the certificate in hexadecimal, read from the file.",
+				Computed:    true,
+			},
+			"not_before": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Description: "Start of the validity period of the certificate, read from the file.",
+				Computed:    true,
+			},
+			"not_after": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Description: "End of the validity period of the certificate, read from the file.",
+				Computed:    true,
+			},
+			"sha1_thumbprint": schema.StringAttribute{
+				Description: "SHA-1 thumbprint of the certificate in hexadecimal, read from the file.",
+				Computed:    true,
+			},
+			"sha256_fingerprint": schema.StringAttribute{
+				Description: "SHA-256 fingerprint of the certificate in hexadecimal, read from the file.",
+				Computed:    true,
+			},
+			"dns_names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "DNS names of the subject alternative name extension of the certificate, read from the file.",
+				Computed:    true,
+			},
+
+			"expiry_warning_days": schema.Int64Attribute{
+				Description: "Number of days before the expiration from which plans report a warning. Overrides the certificate_expiry_warning_days of the provider. Set to 0 to disable the warning.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"fail_if_expired": schema.BoolAttribute{
+				Description: "Fail the plan when the certificate is expired. Overrides the certificate_fail_if_expired of the provider.",
+				Optional:    true,
+			},
+
 			"password": schema.StringAttribute{
 				Description: "Certificate password",
 				Computed:    true,
@@ -154,18 +219,27 @@ func (d *EntryCertificateDataSource) Schema(ctx context.Context, req datasource.
 	}
 }
 
+func (d *EntryCertificateDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *EntryCertificateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*dvls.Client)
+	client, ok := req.ProviderData.(*dvlsClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *dvls.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.dvlsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -175,6 +249,11 @@ func (d *EntryCertificateDataSource) Configure(ctx context.Context, req datasour
 }
 
 func (d *EntryCertificateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
 	var data *EntryCertificateDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -182,32 +261,32 @@ func (d *EntryCertificateDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	entrycertificateId := data.Id.ValueString()
-
-	entrycertificate, err := d.client.Entries.Certificate.Get(entrycertificateId)
+	entrycertificateId, err := fetchLegacyEntryId(ctx, d.client, data.VaultId, data.Id, data.Name, data.Folder, dvls.ServerConnectionDocument, dvls.ServerConnectionSubTypeCertificate)
 	if err != nil {
-		if strings.Contains(err.Error(), dvls.SaveResultNotFound.String()) {
-			resp.State.RemoveResource(ctx)
+		if errors.Is(err, dvls.ErrMultipleEntriesFound) {
+			resp.Diagnostics.AddError(
+				"multiple entries found",
+				fmt.Sprintf("more than one certificate entry named %q found, use id to target the correct one", data.Name.ValueString()),
+			)
 			return
 		}
-		resp.Diagnostics.AddError("unable to read certificate entry", err.Error())
+		addDvlsError(&resp.Diagnostics, "unable to read certificate entry", err)
 		return
 	}
 
-	entrycertificate, err = d.client.Entries.Certificate.GetPassword(entrycertificate)
+	entrycertificate, entryBytes, err := readCertificateEntry(ctx, d.client, entrycertificateId)
 	if err != nil {
-		resp.Diagnostics.AddError("unable to read certificate entry sensitive information", err.Error())
+		addDvlsError(&resp.Diagnostics, "unable to read certificate entry", err)
 		return
 	}
 
-	entryBytes, err := d.client.Entries.Certificate.GetFileContent(entrycertificate.Id)
-	if err != nil {
-		resp.Diagnostics.AddError("unable to read certificate entry content", err.Error())
+	diagsModel := setEntryCertificateDataModel(ctx, entrycertificate, data, entryBytes)
+	resp.Diagnostics.Append(diagsModel...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	diagsModel := setEntryCertificateDataModel(ctx, entrycertificate, data, entryBytes)
-	resp.Diagnostics.Append(diagsModel...)
+	d.client.certificateExpiryFor(data.EntryCertificateExpiryModel).check(&resp.Diagnostics, path.Root("expiration"), entrycertificate.Name, entrycertificate.Expiration, time.Now())
 	if resp.Diagnostics.HasError() {
 		return
 	}
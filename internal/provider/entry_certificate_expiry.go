@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EntryCertificateExpiryModel describes the attributes overriding the provider
+// settings that report the expiration of a certificate entry.
+type EntryCertificateExpiryModel struct {
+	ExpiryWarningDays types.Int64 `tfsdk:"expiry_warning_days"`
+	FailIfExpired     types.Bool  `tfsdk:"fail_if_expired"`
+}
+
+// certificateExpiry describes when the expiration of a certificate entry is
+// reported during plans. Nothing is reported when both fields are unset.
+type certificateExpiry struct {
+	// warningDays is the number of days before the expiration from which a
+	// warning is reported. No warning is reported when it is 0.
+	warningDays int64
+
+	// failIfExpired reports an error instead of a warning for an expired
+	// certificate.
+	failIfExpired bool
+}
+
+// newCertificateExpiry returns the settings of the provider configuration.
+// Unknown values are ignored.
+func newCertificateExpiry(data DvlsProviderModel) certificateExpiry {
+	return certificateExpiry{
+		warningDays:   data.CertificateExpiryWarningDays.ValueInt64(),
+		failIfExpired: data.CertificateFailIfExpired.ValueBool(),
+	}
+}
+
+// certificateExpiryFor returns the provider settings overridden by the
+// attributes of an entry.
+func (c *dvlsClient) certificateExpiryFor(m EntryCertificateExpiryModel) certificateExpiry {
+	var expiry certificateExpiry
+	if c != nil {
+		expiry = c.certificateExpiry
+	}
+
+	if !m.ExpiryWarningDays.IsNull() && !m.ExpiryWarningDays.IsUnknown() {
+		expiry.warningDays = m.ExpiryWarningDays.ValueInt64()
+	}
+
+	if !m.FailIfExpired.IsNull() && !m.FailIfExpired.IsUnknown() {
+		expiry.failIfExpired = m.FailIfExpired.ValueBool()
+	}
+
+	return expiry
+}
+
+// check reports the expiration of the certificate entry name on the
+// attribute at attributePath when it is past or within the warning window.
+func (e certificateExpiry) check(diags *diag.Diagnostics, attributePath path.Path, name string, expiration time.Time, now time.Time) {
+	if e.warningDays <= 0 && !e.failIfExpired {
+		return
+	}
+
+	if !expiration.After(now) {
+		summary := "certificate expired"
+		detail := fmt.Sprintf("The certificate %q expired on %s.", name, expiration.Format(time.RFC3339))
+
+		if e.failIfExpired {
+			diags.AddAttributeError(attributePath, summary, detail+" Renew the certificate, or set fail_if_expired to false to plan anyway.")
+		} else {
+			diags.AddAttributeWarning(attributePath, summary, detail)
+		}
+
+		return
+	}
+
+	if e.warningDays <= 0 || expiration.After(now.AddDate(0, 0, int(e.warningDays))) {
+		return
+	}
+
+	days := int64(expiration.Sub(now).Hours() / 24)
+	diags.AddAttributeWarning(
+		attributePath,
+		"certificate expiring soon",
+		fmt.Sprintf("The certificate %q expires on %s, in %d day(s), within the %d day(s) warning window.", name, expiration.Format(time.RFC3339), days, e.warningDays),
+	)
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCertificateExpiry_check(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		expiry       certificateExpiry
+		expiration   time.Time
+		wantWarnings int
+		wantErrors   int
+	}{
+		"disabled":         {expiry: certificateExpiry{}, expiration: now.AddDate(0, 0, -1)},
+		"outside window":   {expiry: certificateExpiry{warningDays: 30}, expiration: now.AddDate(0, 0, 31)},
+		"within window":    {expiry: certificateExpiry{warningDays: 30}, expiration: now.AddDate(0, 0, 29), wantWarnings: 1},
+		"expired warning":  {expiry: certificateExpiry{warningDays: 30}, expiration: now.AddDate(0, 0, -1), wantWarnings: 1},
+		"expired error":    {expiry: certificateExpiry{failIfExpired: true}, expiration: now.AddDate(0, 0, -1), wantErrors: 1},
+		"valid with error": {expiry: certificateExpiry{failIfExpired: true}, expiration: now.AddDate(0, 0, 1)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			test.expiry.check(&diags, path.Root("expiration"), "certificate", test.expiration, now)
+
+			if diags.WarningsCount() != test.wantWarnings || diags.ErrorsCount() != test.wantErrors {
+				t.Errorf("expected %d warning(s) and %d error(s), got %v", test.wantWarnings, test.wantErrors, diags)
+			}
+		})
+	}
+}
+
+func TestDvlsClient_certificateExpiryFor(t *testing.T) {
+	client := &dvlsClient{certificateExpiry: certificateExpiry{warningDays: 30, failIfExpired: true}}
+
+	expiry := client.certificateExpiryFor(EntryCertificateExpiryModel{
+		ExpiryWarningDays: types.Int64Value(0),
+		FailIfExpired:     types.BoolNull(),
+	})
+	if expiry.warningDays != 0 || !expiry.failIfExpired {
+		t.Errorf("expected the warning days to be overridden only, got %+v", expiry)
+	}
+
+	var unconfigured *dvlsClient
+	expiry = unconfigured.certificateExpiryFor(EntryCertificateExpiryModel{FailIfExpired: types.BoolValue(true)})
+	if expiry.warningDays != 0 || !expiry.failIfExpired {
+		t.Errorf("expected the entry settings without a provider, got %+v", expiry)
+	}
+}
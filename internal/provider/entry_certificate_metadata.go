@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// EntryCertificateMetadataModel describes the attributes read from the content
+// of a certificate entry.
+type EntryCertificateMetadataModel struct {
+	Subject           types.String      `tfsdk:"subject"`
+	Issuer            types.String      `tfsdk:"issuer"`
+	SerialNumber      types.String      `tfsdk:"serial_number"`
+	NotBefore         timetypes.RFC3339 `tfsdk:"not_before"`
+	NotAfter          timetypes.RFC3339 `tfsdk:"not_after"`
+	Sha1Thumbprint    types.String      `tfsdk:"sha1_thumbprint"`
+	Sha256Fingerprint types.String      `tfsdk:"sha256_fingerprint"`
+	DnsNames          types.List        `tfsdk:"dns_names"`
+}
+
+func newEntryCertificateMetadataNull() EntryCertificateMetadataModel {
+	return EntryCertificateMetadataModel{
+		Subject:           types.StringNull(),
+		Issuer:            types.StringNull(),
+		SerialNumber:      types.StringNull(),
+		NotBefore:         timetypes.NewRFC3339Null(),
+		NotAfter:          timetypes.NewRFC3339Null(),
+		Sha1Thumbprint:    types.StringNull(),
+		Sha256Fingerprint: types.StringNull(),
+		DnsNames:          types.ListNull(types.StringType),
+	}
+}
+
+func newEntryCertificateMetadataUnknown() EntryCertificateMetadataModel {
+	return EntryCertificateMetadataModel{
+		Subject:           types.StringUnknown(),
+		Issuer:            types.StringUnknown(),
+		SerialNumber:      types.StringUnknown(),
+		NotBefore:         timetypes.NewRFC3339Unknown(),
+		NotAfter:          timetypes.NewRFC3339Unknown(),
+		Sha1Thumbprint:    types.StringUnknown(),
+		Sha256Fingerprint: types.StringUnknown(),
+		DnsNames:          types.ListUnknown(types.StringType),
+	}
+}
+
+// readCertificateMetadata returns the metadata of the certificate in content,
+// or null values and an error when the content cannot be read.
+func readCertificateMetadata(content []byte, password string) (EntryCertificateMetadataModel, error) {
+	certificate, err := parseCertificate(content, password)
+	if err != nil {
+		return newEntryCertificateMetadataNull(), err
+	}
+
+	dnsNames := make([]attr.Value, 0, len(certificate.DNSNames))
+	for _, name := range certificate.DNSNames {
+		dnsNames = append(dnsNames, types.StringValue(name))
+	}
+
+	sha1Sum := sha1.Sum(certificate.Raw)
+	sha256Sum := sha256.Sum256(certificate.Raw)
+
+	return EntryCertificateMetadataModel{
+		Subject:           types.StringValue(certificate.Subject.String()),
+		Issuer:            types.StringValue(certificate.Issuer.String()),
+		SerialNumber:      types.StringValue(strings.ToUpper(certificate.SerialNumber.Text(16))),
+		NotBefore:         timetypes.NewRFC3339TimeValue(certificate.NotBefore.UTC()),
+		NotAfter:          timetypes.NewRFC3339TimeValue(certificate.NotAfter.UTC()),
+		Sha1Thumbprint:    types.StringValue(strings.ToUpper(hex.EncodeToString(sha1Sum[:]))),
+		Sha256Fingerprint: types.StringValue(strings.ToUpper(hex.EncodeToString(sha256Sum[:]))),
+		DnsNames:          types.ListValueMust(types.StringType, dnsNames),
+	}, nil
+}
+
+// parseCertificate returns the certificate in content, which can be PEM, DER
+// or PKCS#12 encoded. For a chain, the first certificate is returned.
+func parseCertificate(content []byte, password string) (*x509.Certificate, error) {
+	if bytes.Contains(content, []byte("-----BEGIN")) {
+		rest := content
+
+		for {
+			var block *pem.Block
+
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				return nil, fmt.Errorf("no certificate found in the PEM content")
+			}
+
+			if block.Type == "CERTIFICATE" {
+				return x509.ParseCertificate(block.Bytes)
+			}
+		}
+	}
+
+	if certificate, err := x509.ParseCertificate(content); err == nil {
+		return certificate, nil
+	}
+
+	_, certificate, _, err := pkcs12.DecodeChain(content, password)
+	if err == nil {
+		return certificate, nil
+	}
+
+	// PKCS#12 files without a private key only hold certificates.
+	certificates, trustStoreErr := pkcs12.DecodeTrustStore(content, password)
+	if trustStoreErr == nil && len(certificates) > 0 {
+		return certificates[0], nil
+	}
+
+	return nil, fmt.Errorf("the content is not a PEM, DER or PKCS#12 certificate: %w", err)
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+func TestReadCertificateMetadata(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %s", err)
+	}
+
+	notAfter := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(0xABCDEF),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		NotAfter:     notAfter,
+		DNSNames:     []string{"example.com", "www.example.com"},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unable to create certificate: %s", err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("unable to parse certificate: %s", err)
+	}
+
+	pfx, err := pkcs12.Modern.Encode(key, certificate, nil, "secret")
+	if err != nil {
+		t.Fatalf("unable to encode PKCS#12: %s", err)
+	}
+
+	trustStore, err := pkcs12.Modern.EncodeTrustStore([]*x509.Certificate{certificate}, "secret")
+	if err != nil {
+		t.Fatalf("unable to encode PKCS#12 trust store: %s", err)
+	}
+
+	tests := map[string]struct {
+		content  []byte
+		password string
+		wantErr  bool
+	}{
+		"pem":                {content: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})},
+		"pem-key":            {content: append(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("key")}), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)},
+		"der":                {content: der},
+		"pkcs12":             {content: pfx, password: "secret"},
+		"trust-store":        {content: trustStore, password: "secret"},
+		"wrong-password":     {content: pfx, password: "wrong", wantErr: true},
+		"pem-no-certificate": {content: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("key")}), wantErr: true},
+		"invalid":            {content: []byte("not a certificate"), wantErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			metadata, err := readCertificateMetadata(test.content, test.password)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+
+				if !metadata.NotAfter.IsNull() {
+					t.Errorf("expected null metadata, got %s", metadata.NotAfter)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if metadata.Subject.ValueString() != "CN=example.com" || metadata.SerialNumber.ValueString() != "ABCDEF" {
+				t.Errorf("unexpected subject or serial number %s %s", metadata.Subject, metadata.SerialNumber)
+			}
+
+			got, diags := metadata.NotAfter.ValueRFC3339Time()
+			if diags.HasError() || !got.Equal(notAfter) {
+				t.Errorf("expected not_after to be %s, got %s", notAfter, metadata.NotAfter)
+			}
+
+			var dnsNames []string
+			metadata.DnsNames.ElementsAs(context.Background(), &dnsNames, false)
+			if len(dnsNames) != 2 || dnsNames[1] != "www.example.com" {
+				t.Errorf("unexpected dns names %v", dnsNames)
+			}
+
+			if len(metadata.Sha256Fingerprint.ValueString()) != 64 {
+				t.Errorf("unexpected sha256 fingerprint %s", metadata.Sha256Fingerprint)
+			}
+		})
+	}
+}
@@ -2,27 +2,31 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Devolutions/go-dvls"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &EntryCertificateResource{}
 var _ resource.ResourceWithImportState = &EntryCertificateResource{}
+var _ resource.ResourceWithModifyPlan = &EntryCertificateResource{}
 
 func NewEntryCertificateResource() resource.Resource {
 	return &EntryCertificateResource{}
@@ -30,7 +34,7 @@ func NewEntryCertificateResource() resource.Resource {
 
 // EntryCertificateResource defines the resource implementation.
 type EntryCertificateResource struct {
-	client *dvls.Client
+	client *dvlsClient
 }
 
 // EntryCertificateResourceModel describes the resource data model.
@@ -47,6 +51,12 @@ type EntryCertificateResourceModel struct {
 	Password types.String `tfsdk:"password"`
 	File     types.Object `tfsdk:"file"`
 	Url      types.Object `tfsdk:"url"`
+
+	// Read from the certificate file
+	EntryCertificateMetadataModel
+
+	// Expiration reporting
+	EntryCertificateExpiryModel
 }
 
 type EntryCertificateResourceModelData struct {
@@ -112,8 +122,9 @@ func (r *EntryCertificateResource) Schema(ctx context.Context, req resource.Sche
 			},
 			"expiration": schema.StringAttribute{
 				CustomType:  timetypes.RFC3339Type{},
-				Description: "Certificate expiration date, in RFC3339 format (e.g. 2022-12-31T23:59:59-05:00)",
-				Required:    true,
+				Description: "Certificate expiration date, in RFC3339 format (e.g. 2022-12-31T23:59:59-05:00). Defaults to `not_after` when the certificate file can be read, and must match it when set. Required when the certificate is a url.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"tags": schema.ListAttribute{
 				ElementType: types.StringType,
@@ -121,16 +132,61 @@ func (r *EntryCertificateResource) Schema(ctx context.Context, req resource.Sche
 				Optional:    true,
 			},
 
+			"subject": schema.StringAttribute{
+				Description: "Subject of the certificate, read from the file.",
+				Computed:    true,
+			},
+			"issuer": schema.StringAttribute{
+				Description: "Issuer of the certificate, read from the file.",
+				Computed:    true,
+			},
+			"serial_number": schema.StringAttribute{
+				Description: "Serial number of the certificate in hexadecimal, read from the file.",
+				Computed:    true,
+			},
+			"not_before": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Description: "Start of the validity period of the certificate, read from the file.",
+				Computed:    true,
+			},
+			"not_after": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Description: "End of the validity period of the certificate, read from the file.",
+				Computed:    true,
+			},
+			"sha1_thumbprint": schema.StringAttribute{
+				Description: "SHA-1 thumbprint of the certificate in hexadecimal, read from the file.",
+				Computed:    true,
+			},
+			"sha256_fingerprint": schema.StringAttribute{
+				Description: "SHA-256 fingerprint of the certificate in hexadecimal, read from the file.",
+				Computed:    true,
+			},
+			"dns_names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "DNS names of the subject alternative name extension of the certificate, read from the file.",
+				Computed:    true,
+			},
+
+			"expiry_warning_days": schema.Int64Attribute{
+				Description: "Number of days before the expiration from which plans report a warning. Overrides the certificate_expiry_warning_days of the provider. Set to 0 to disable the warning.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"fail_if_expired": schema.BoolAttribute{
+				Description: "Fail the plan when the certificate is expired. Overrides the certificate_fail_if_expired of the provider.",
+				Optional:    true,
+			},
+
 			"password": schema.StringAttribute{
 				Description: "Certificate password",
 				Optional:    true,
 				Sensitive:   true,
 			},
 			"file": schema.SingleNestedAttribute{
-				Description:   "Certificate file. Either file or url must be specified.",
-				Optional:      true,
-				PlanModifiers: []planmodifier.Object{objectplanmodifier.RequiresReplaceIfConfigured()},
-				Sensitive:     true,
+				Description: "Certificate file. Either file or url must be specified. Changing the file updates the content of the entry in place.",
+				Optional:    true,
+				Sensitive:   true,
 
 				Attributes: map[string]schema.Attribute{
 					"content_b64": schema.StringAttribute{
@@ -146,9 +202,8 @@ func (r *EntryCertificateResource) Schema(ctx context.Context, req resource.Sche
 				Validators: []validator.Object{objectvalidator.ExactlyOneOf(path.MatchRoot("url"))},
 			},
 			"url": schema.SingleNestedAttribute{
-				Description:   "Certificate url. Either file or url must be specified.",
-				Optional:      true,
-				PlanModifiers: []planmodifier.Object{objectplanmodifier.RequiresReplaceIfConfigured()},
+				Description: "Certificate url. Either file or url must be specified. Changing the url updates the entry in place.",
+				Optional:    true,
 
 				Attributes: map[string]schema.Attribute{
 					"url": schema.StringAttribute{
@@ -174,12 +229,12 @@ func (r *EntryCertificateResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*dvls.Client)
+	client, ok := req.ProviderData.(*dvlsClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *dvls.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.dvlsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -188,7 +243,103 @@ func (r *EntryCertificateResource) Configure(ctx context.Context, req resource.C
 	r.client = client
 }
 
+// ModifyPlan reads the metadata of the certificate from the file of the plan,
+// defaults the expiration to the end of its validity period and reports when
+// the certificate is expired or expiring soon.
+func (r *EntryCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to read when the resource is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *EntryCertificateResourceModel
+	var configExpiration timetypes.RFC3339
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("expiration"), &configExpiration)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var file *EntryCertificateResourceModelFile
+	if !plan.File.IsNull() && !plan.File.IsUnknown() {
+		resp.Diagnostics.Append(plan.File.As(ctx, &file, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.EntryCertificateMetadataModel = newEntryCertificateMetadataNull()
+
+	switch {
+	case plan.File.IsUnknown() || (file != nil && file.ContentB64.IsUnknown()) || plan.Password.IsUnknown():
+		plan.EntryCertificateMetadataModel = newEntryCertificateMetadataUnknown()
+	case file != nil:
+		content, err := base64.StdEncoding.DecodeString(file.ContentB64.ValueString())
+		if err == nil {
+			plan.EntryCertificateMetadataModel, err = readCertificateMetadata(content, plan.Password.ValueString())
+		}
+
+		if err != nil && configExpiration.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("file").AtName("content_b64"),
+				"unable to read certificate",
+				fmt.Sprintf("The expiration cannot be read from the certificate file: %s. Set expiration, or check the file content and the password.", err),
+			)
+			return
+		}
+	}
+
+	switch {
+	case configExpiration.IsUnknown():
+	case configExpiration.IsNull():
+		if plan.NotAfter.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expiration"),
+				"missing certificate expiration",
+				"expiration must be set when the certificate is a url.",
+			)
+			return
+		}
+
+		plan.Expiration = plan.NotAfter
+	case !plan.NotAfter.IsNull() && !plan.NotAfter.IsUnknown():
+		expiration, diags := configExpiration.ValueRFC3339Time()
+		resp.Diagnostics.Append(diags...)
+		notAfter, diags := plan.NotAfter.ValueRFC3339Time()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !expiration.Equal(notAfter) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expiration"),
+				"certificate expiration mismatch",
+				fmt.Sprintf("expiration is %s, but the certificate file expires at %s. Remove expiration to use the date of the certificate file.", configExpiration.ValueString(), plan.NotAfter.ValueString()),
+			)
+			return
+		}
+	}
+
+	if !plan.Expiration.IsNull() && !plan.Expiration.IsUnknown() {
+		expiration, diags := plan.Expiration.ValueRFC3339Time()
+		resp.Diagnostics.Append(diags...)
+		r.client.certificateExpiryFor(plan.EntryCertificateExpiryModel).check(&resp.Diagnostics, path.Root("expiration"), plan.Name.ValueString(), expiration, time.Now())
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
 func (r *EntryCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
 	plans, diags := getPlans(ctx, req.Plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -197,20 +348,14 @@ func (r *EntryCertificateResource) Create(ctx context.Context, req resource.Crea
 
 	entrycertificate := newEntryCertificateFromResourceModel(&plans)
 
-	entrycertificate = updateCertificateContent(plans, r.client, entrycertificate, &resp.Diagnostics)
+	entrycertificate = createCertificateEntry(ctx, plans, r.client, entrycertificate, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	entrycertificate, err := r.client.Entries.Certificate.GetPassword(entrycertificate)
-	if err != nil {
-		resp.Diagnostics.AddError("unable to read certificate entry sensitive information", err.Error())
-		return
-	}
-
-	entryBytes, err := r.client.Entries.Certificate.GetFileContent(entrycertificate.Id)
+	entrycertificate, entryBytes, err := readCertificateEntry(ctx, r.client, entrycertificate.Id)
 	if err != nil {
-		resp.Diagnostics.AddError("unable to read certificate entry content", err.Error())
+		addDvlsError(&resp.Diagnostics, "unable to read created certificate entry", err)
 		return
 	}
 
@@ -224,33 +369,24 @@ func (r *EntryCertificateResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *EntryCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Keep the current state until the provider configuration is known.
+	if !r.client.configured() {
+		return
+	}
+
 	states, diags := getPlans(ctx, req.State)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	entrycertificate := newEntryCertificateFromResourceModel(&states)
-
-	entrycertificate, err := r.client.Entries.Certificate.Get(entrycertificate.Id)
+	entrycertificate, entryBytes, err := readCertificateEntry(ctx, r.client, states.Data.Id.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), dvls.SaveResultNotFound.String()) {
+		if isNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("unable to read certificate entry", err.Error())
-		return
-	}
-
-	entrycertificate, err = r.client.Entries.Certificate.GetPassword(entrycertificate)
-	if err != nil {
-		resp.Diagnostics.AddError("unable to read certificate entry sensitive information", err.Error())
-		return
-	}
-
-	entryBytes, err := r.client.Entries.Certificate.GetFileContent(entrycertificate.Id)
-	if err != nil {
-		resp.Diagnostics.AddError("unable to read certificate entry content", err.Error())
+		addDvlsError(&resp.Diagnostics, "unable to read certificate entry", err)
 		return
 	}
 
@@ -264,17 +400,52 @@ func (r *EntryCertificateResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *EntryCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
 	plans, diags := getPlans(ctx, req.Plan)
 	resp.Diagnostics.Append(diags...)
+	states, diags := getPlans(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	entrycertificate := newEntryCertificateFromResourceModel(&plans)
 
-	_, err := r.client.Entries.Certificate.Update(entrycertificate)
+	// A new file or url is saved with its content, while other changes only
+	// update the entry.
+	if plans.Data.File.Equal(states.Data.File) && plans.Data.Url.Equal(states.Data.Url) {
+		_, err := r.client.Entries.Certificate.UpdateWithContext(ctx, entrycertificate)
+		if err != nil {
+			addDvlsError(&resp.Diagnostics, "unable to update certificate entry", err)
+			return
+		}
+	} else {
+		content, err := certificateContent(plans)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("file").AtName("content_b64"), "unable to update certificate entry", err.Error())
+			return
+		}
+
+		err = saveCertificateEntryContent(ctx, r.client, entrycertificate, content)
+		if err != nil {
+			addDvlsError(&resp.Diagnostics, "unable to update certificate entry content", err)
+			return
+		}
+	}
+
+	entrycertificate, entryBytes, err := readCertificateEntry(ctx, r.client, entrycertificate.Id)
 	if err != nil {
-		resp.Diagnostics.AddError("unable to update certificate entry", err.Error())
+		addDvlsError(&resp.Diagnostics, "unable to read updated certificate entry", err)
+		return
+	}
+
+	diagsModel := setEntryCertificateResourceModel(ctx, entrycertificate, plans.Data, entryBytes)
+	resp.Diagnostics.Append(diagsModel...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -282,6 +453,11 @@ func (r *EntryCertificateResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *EntryCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
 	var state *EntryCertificateResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -289,17 +465,53 @@ func (r *EntryCertificateResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	err := r.client.Entries.Certificate.Delete(state.Id.ValueString())
+	err := r.client.Entries.Certificate.DeleteWithContext(ctx, state.Id.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), dvls.SaveResultNotFound.String()) {
+		if isNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("unable to delete certificate entry", err.Error())
+		addDvlsError(&resp.Diagnostics, "unable to delete certificate entry", err)
 		return
 	}
 }
 
+// ImportState accepts <vault_id>/<entry_id> like the other entries, or the
+// entry ID alone as in the previous versions of the provider. The file or url
+// of the entry is then read from DVLS by Read.
 func (r *EntryCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	vaultId, entryId := "", req.ID
+	if strings.Contains(req.ID, "/") {
+		var err error
+
+		vaultId, entryId, err = parseEntryImportId(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Resource ID", err.Error())
+			return
+		}
+	}
+
+	entryType, err := r.client.getLegacyEntryType(ctx, entryId)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to read entry", err)
+		return
+	}
+
+	if entryType.ConnectionType != dvls.ServerConnectionDocument || entryType.ConnectionSubType != dvls.ServerConnectionSubTypeCertificate {
+		resp.Diagnostics.AddError("invalid entry type", "expected a certificate entry.")
+		return
+	}
+
+	if vaultId != "" && entryType.VaultId != vaultId {
+		resp.Diagnostics.AddError("invalid vault id", fmt.Sprintf("entry %s does not belong to vault %s.", entryId, vaultId))
+		return
+	}
+
+	resp.State.SetAttribute(ctx, path.Root("vault_id"), entryType.VaultId)
+	resp.State.SetAttribute(ctx, path.Root("id"), entryId)
 }
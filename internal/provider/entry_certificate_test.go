@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSaveCertificateEntryContent(t *testing.T) {
+	const entryId = "00000000-0000-0000-0000-000000000002"
+
+	var savedEntry map[string]any
+	var uploaded []byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == isLoggedEndpoint:
+			w.Write([]byte("true"))
+		case r.Method == http.MethodPut && r.URL.Path == entryPartialEndpoint+"/save":
+			if err := json.NewDecoder(r.Body).Decode(&savedEntry); err != nil {
+				t.Errorf("unexpected entry body: %s", err)
+			}
+			w.Write([]byte(`{"result":1,"data":{"id":"` + entryId + `"}}`))
+		case r.Method == http.MethodPost && r.URL.Path == attachmentEndpoint+"/save":
+			w.Write([]byte(`{"result":1,"data":{"id":"attachment-id"}}`))
+		case r.Method == http.MethodPost && r.URL.Path == attachmentEndpoint+"/attachment-id/document":
+			uploaded, _ = io.ReadAll(r.Body)
+			w.Write([]byte(`{"result":1}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Cleanup(func() { http.DefaultTransport = defaultTransport })
+	http.DefaultTransport = newTokenLoginTransport(defaultTransport, "token")
+
+	client, err := dvls.NewClient("", "", server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	dvlsClient := &dvlsClient{Client: &client, baseUri: server.URL}
+
+	entry := dvls.EntryCertificate{
+		Id:                    entryId,
+		VaultId:               "00000000-0000-0000-0000-000000000001",
+		Name:                  "certificate",
+		CertificateIdentifier: "certificate.pem",
+	}
+
+	err = saveCertificateEntryContent(context.Background(), dvlsClient, entry, []byte("new content"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data, _ := savedEntry["data"].(map[string]any)
+	if data["dataMode"] != float64(dvls.EntryCertificateDataModeFile) || data["documentSize"] != float64(len("new content")) {
+		t.Errorf("expected the entry to be saved as a file of %d bytes, got %v", len("new content"), data)
+	}
+
+	if string(uploaded) != "new content" {
+		t.Errorf("expected the new content to be uploaded, got %q", uploaded)
+	}
+
+	uploaded = nil
+	entry.CertificateIdentifier = "https://example.com/certificate.pem"
+
+	err = saveCertificateEntryContent(context.Background(), dvlsClient, entry, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data, _ = savedEntry["data"].(map[string]any)
+	if data["dataMode"] != float64(dvls.EntryCertificateDataModeURL) || data["fileName"] != entry.CertificateIdentifier {
+		t.Errorf("expected the entry to be saved as a url, got %v", data)
+	}
+
+	if uploaded != nil {
+		t.Errorf("expected no content to be uploaded for a url")
+	}
+}
+
+func TestEntryCertificateResource_ImportState(t *testing.T) {
+	const vaultId = "00000000-0000-0000-0000-000000000001"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case isLoggedEndpoint:
+			w.Write([]byte("true"))
+		case entryPartialEndpoint + "/certificate":
+			w.Write([]byte(`{"result":1,"data":{"repositoryId":"` + vaultId + `","connectionType":45,"connectionSubType":"Certificate"}}`))
+		case entryPartialEndpoint + "/host":
+			w.Write([]byte(`{"result":1,"data":{"repositoryId":"` + vaultId + `","connectionType":2}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Cleanup(func() { http.DefaultTransport = defaultTransport })
+	http.DefaultTransport = newTokenLoginTransport(defaultTransport, "token")
+
+	client, err := dvls.NewClient("", "", server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r := &EntryCertificateResource{client: &dvlsClient{Client: &client, baseUri: server.URL}}
+
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	tests := map[string]struct {
+		id      string
+		wantErr bool
+	}{
+		"vault and entry": {id: vaultId + "/certificate"},
+		"entry only":      {id: "certificate"},
+		"other vault":     {id: "00000000-0000-0000-0000-000000000003/certificate", wantErr: true},
+		"other type":      {id: vaultId + "/host", wantErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			r.ImportState(ctx, resource.ImportStateRequest{ID: test.id}, &resp)
+
+			if test.wantErr {
+				if !resp.Diagnostics.HasError() {
+					t.Fatalf("expected an error")
+				}
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var gotVaultId, gotId types.String
+			resp.State.GetAttribute(ctx, path.Root("vault_id"), &gotVaultId)
+			resp.State.GetAttribute(ctx, path.Root("id"), &gotId)
+
+			if gotVaultId.ValueString() != vaultId || gotId.ValueString() != "certificate" {
+				t.Errorf("unexpected imported state vault_id=%s id=%s", gotVaultId, gotId)
+			}
+		})
+	}
+}
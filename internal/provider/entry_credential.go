@@ -1,23 +1,201 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func fetchCredentialEntry(client *dvls.Client, vaultId, id, name, folder types.String, subType string) (dvls.Entry, error) {
+// entryCredentialType describes a credential entry subtype. The resource, the
+// data source and the ephemeral resource of every subtype are built from it,
+// with T being the type of the entry data in go-dvls.
+type entryCredentialType[T any] struct {
+	// typeName is appended to the provider type name (ex.: "entry_credential_api_key").
+	typeName string
+	subType  string
+	// title names the subtype in the schema descriptions (ex.: "API Key").
+	title string
+	// label names the subtype in the diagnostics (ex.: "api key").
+	label  string
+	fields []entryCredentialField[T]
+}
+
+// entryCredentialField maps a string attribute of a credential entry to a field
+// of its data.
+type entryCredentialField[T any] struct {
+	name        string
+	description string
+	sensitive   bool
+	// writeOnly adds the <name>_wo and <name>_wo_version attributes to the
+	// resource, to send the value to DVLS without storing it in the state.
+	writeOnly bool
+	// noun names the value in the description of <name>_wo_version.
+	noun  string
+	value func(data *T) *string
+}
+
+func (t entryCredentialType[T]) description() string {
+	return fmt.Sprintf("A DVLS %s Credential Entry", t.title)
+}
+
+func (f entryCredentialField[T]) writeOnlyName() string {
+	return f.name + "_wo"
+}
+
+func (f entryCredentialField[T]) writeOnlyVersionName() string {
+	return f.name + "_wo_version"
+}
+
+// writeOnlyDescription returns the description of the write-only attribute of
+// the field.
+func (f entryCredentialField[T]) writeOnlyDescription() string {
+	return strings.TrimSuffix(f.description, ".") + ", write-only. It is sent to DVLS but never stored in the Terraform state. Requires Terraform 1.11 or later."
+}
+
+func (f entryCredentialField[T]) writeOnlyVersionDescription() string {
+	return fmt.Sprintf("The version of `%s`. Change this value to send a new %s to DVLS.", f.writeOnlyName(), f.noun)
+}
+
+// entryCredentialModel holds the values of the attributes of a credential entry
+// resource, data source or ephemeral resource by attribute name.
+type entryCredentialModel struct {
+	attrTypes map[string]attr.Type
+	values    map[string]attr.Value
+}
+
+// getEntryCredentialModel reads the model with get, which is the Get method of
+// a plan, a configuration or a state.
+func getEntryCredentialModel(ctx context.Context, get func(context.Context, any) diag.Diagnostics) (entryCredentialModel, diag.Diagnostics) {
+	var object types.Object
+
+	diags := get(ctx, &object)
+	if diags.HasError() {
+		return entryCredentialModel{}, diags
+	}
+
+	return entryCredentialModel{
+		attrTypes: object.AttributeTypes(ctx),
+		values:    object.Attributes(),
+	}, diags
+}
+
+// setEntryCredentialModel writes the model with set, which is the Set method of
+// a state or an ephemeral result.
+func setEntryCredentialModel(ctx context.Context, set func(context.Context, any) diag.Diagnostics, m entryCredentialModel) diag.Diagnostics {
+	object, diags := types.ObjectValue(m.attrTypes, m.values)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, set(ctx, object)...)
+}
+
+func (m entryCredentialModel) string(name string) types.String {
+	value, _ := m.values[name].(types.String)
+	return value
+}
+
+func (m entryCredentialModel) isNull(name string) bool {
+	value, ok := m.values[name]
+	return !ok || value.IsNull()
+}
+
+// newEntry builds the entry described by the plan or the state of a credential
+// entry resource. The values of the write-only attributes replace the values of
+// their attribute.
+func (t entryCredentialType[T]) newEntry(m entryCredentialModel) dvls.Entry {
+	var tags []string
+
+	if list, ok := m.values["tags"].(types.List); ok {
+		for _, v := range list.Elements() {
+			if tag, ok := v.(types.String); ok {
+				tags = append(tags, tag.ValueString())
+			}
+		}
+	}
+
+	data := new(T)
+
+	for _, f := range t.fields {
+		value := m.string(f.name).ValueString()
+		if f.writeOnly && !m.isNull(f.writeOnlyName()) {
+			value = m.string(f.writeOnlyName()).ValueString()
+		}
+
+		*f.value(data) = value
+	}
+
+	return dvls.Entry{
+		Id:          m.string("id").ValueString(),
+		VaultId:     m.string("vault_id").ValueString(),
+		Name:        m.string("name").ValueString(),
+		Path:        m.string("folder").ValueString(),
+		Type:        dvls.EntryCredentialType,
+		SubType:     t.subType,
+		Description: m.string("description").ValueString(),
+		Tags:        tags,
+		Data:        data,
+	}
+}
+
+// setModel sets the model to the values of the entry. The values of the fields
+// sent with a write-only attribute are not set, and write-only attributes are
+// always null.
+func (t entryCredentialType[T]) setModel(entry dvls.Entry, m entryCredentialModel) {
+	m.values["id"] = types.StringValue(entry.Id)
+	m.values["vault_id"] = types.StringValue(entry.VaultId)
+	m.values["name"] = types.StringValue(entry.Name)
+	m.values["folder"] = stringValueOrNull(entry.Path)
+	m.values["description"] = stringValueOrNull(entry.Description)
+
+	tags := types.ListNull(types.StringType)
+	if entry.Tags != nil {
+		var elements []attr.Value
+
+		for _, v := range entry.Tags {
+			elements = append(elements, types.StringValue(v))
+		}
+
+		tags = types.ListValueMust(types.StringType, elements)
+	}
+	m.values["tags"] = tags
+
+	data, _ := entry.Data.(*T)
+
+	for _, f := range t.fields {
+		value := types.StringNull()
+		if data != nil && (!f.writeOnly || m.isNull(f.writeOnlyVersionName())) {
+			value = stringValueOrNull(*f.value(data))
+		}
+
+		m.values[f.name] = value
+
+		if _, ok := m.values[f.writeOnlyName()]; ok {
+			m.values[f.writeOnlyName()] = types.StringNull()
+		}
+	}
+}
+
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+func fetchCredentialEntry(ctx context.Context, client *dvlsClient, vaultId, id, name, folder types.String, subType string) (dvls.Entry, error) {
 	if !id.IsNull() && !id.IsUnknown() {
-		entry, err := client.Entries.Credential.GetById(vaultId.ValueString(), id.ValueString())
+		entry, err := client.Entries.Credential.GetByIdWithContext(ctx, vaultId.ValueString(), id.ValueString())
 		if err != nil {
 			return entry, err
 		}
-		if entry.Type != dvls.EntryCredentialType || entry.SubType != subType {
-			return entry, fmt.Errorf("expected entry type %q with subtype %q, got type %q with subtype %q",
-				dvls.EntryCredentialType, subType, entry.Type, entry.SubType)
-		}
-		return entry, nil
+		return entry, checkCredentialSubType(entry, subType)
 	}
 
 	var folderPath *string
@@ -26,5 +204,16 @@ func fetchCredentialEntry(client *dvls.Client, vaultId, id, name, folder types.S
 		folderPath = &v
 	}
 
-	return client.Entries.Credential.GetByName(vaultId.ValueString(), name.ValueString(), subType, dvls.GetByNameOptions{Path: folderPath})
+	return client.Entries.Credential.GetByNameWithContext(ctx, vaultId.ValueString(), name.ValueString(), subType, dvls.GetByNameOptions{Path: folderPath})
+}
+
+// checkCredentialSubType returns an error if entry is not a credential entry of
+// the subtype.
+func checkCredentialSubType(entry dvls.Entry, subType string) error {
+	if entry.Type != dvls.EntryCredentialType || entry.SubType != subType {
+		return fmt.Errorf("expected entry type %q with subtype %q, got type %q with subtype %q",
+			dvls.EntryCredentialType, subType, entry.Type, entry.SubType)
+	}
+
+	return nil
 }
@@ -2,122 +2,46 @@ package provider
 
 import (
 	"github.com/Devolutions/go-dvls"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
-func newEntryCredentialApiKeyFromResourceModel(rm *EntryCredentialApiKeyResourceModel) dvls.Entry {
-	var tags []string
-
-	for _, v := range rm.Tags {
-		tags = append(tags, v.ValueString())
-	}
-
-	entryCredentialApiKey := dvls.Entry{
-		Id:          rm.Id.ValueString(),
-		VaultId:     rm.VaultId.ValueString(),
-		Name:        rm.Name.ValueString(),
-		Path:        rm.Folder.ValueString(),
-		Type:        dvls.EntryCredentialType,
-		SubType:     dvls.EntryCredentialSubTypeApiKey,
-		Description: rm.Description.ValueString(),
-		Tags:        tags,
-		Data: dvls.EntryCredentialApiKeyData{
-			ApiId:    rm.ApiId.ValueString(),
-			ApiKey:   rm.ApiKey.ValueString(),
-			TenantId: rm.TenantId.ValueString(),
+var entryCredentialApiKeyType = entryCredentialType[dvls.EntryCredentialApiKeyData]{
+	typeName: "entry_credential_api_key",
+	subType:  dvls.EntryCredentialSubTypeApiKey,
+	title:    "API Key",
+	label:    "api key",
+	fields: []entryCredentialField[dvls.EntryCredentialApiKeyData]{
+		{
+			name:        "api_id",
+			description: "The entry credential API ID.",
+			value:       func(data *dvls.EntryCredentialApiKeyData) *string { return &data.ApiId },
 		},
-	}
-
-	return entryCredentialApiKey
+		{
+			name:        "api_key",
+			description: "The entry credential API key.",
+			sensitive:   true,
+			writeOnly:   true,
+			noun:        "API key",
+			value:       func(data *dvls.EntryCredentialApiKeyData) *string { return &data.ApiKey },
+		},
+		{
+			name:        "tenant_id",
+			description: "The entry credential tenant ID.",
+			value:       func(data *dvls.EntryCredentialApiKeyData) *string { return &data.TenantId },
+		},
+	},
 }
 
-func setEntryCredentialApiKeyResourceModel(entry dvls.Entry, rm *EntryCredentialApiKeyResourceModel) {
-	var model EntryCredentialApiKeyResourceModel
-
-	model.Id = basetypes.NewStringValue(entry.Id)
-	model.VaultId = basetypes.NewStringValue(entry.VaultId)
-	model.Name = basetypes.NewStringValue(entry.Name)
-
-	if entry.Path != "" {
-		model.Folder = basetypes.NewStringValue(entry.Path)
-	}
-
-	if entry.Description != "" {
-		model.Description = basetypes.NewStringValue(entry.Description)
-	}
-
-	if entry.Tags != nil {
-		var tagsBase []types.String
-
-		for _, v := range entry.Tags {
-			tagsBase = append(tagsBase, basetypes.NewStringValue(v))
-		}
-
-		model.Tags = tagsBase
-	}
-
-	if entry.Data != nil {
-		data, ok := entry.GetCredentialApiKeyData()
-		if ok {
-			if data.ApiId != "" {
-				model.ApiId = basetypes.NewStringValue(data.ApiId)
-			}
-
-			if data.ApiKey != "" {
-				model.ApiKey = basetypes.NewStringValue(data.ApiKey)
-			}
-
-			if data.TenantId != "" {
-				model.TenantId = basetypes.NewStringValue(data.TenantId)
-			}
-		}
-	}
-
-	*rm = model
+func NewEntryCredentialApiKeyResource() resource.Resource {
+	return newEntryCredentialResource(entryCredentialApiKeyType)
 }
 
-func setEntryCredentialApiKeyDataModel(entry dvls.Entry, dsm *EntryCredentialApiKeyDataSourceModel) {
-	var model EntryCredentialApiKeyDataSourceModel
-
-	model.Id = basetypes.NewStringValue(entry.Id)
-	model.VaultId = basetypes.NewStringValue(entry.VaultId)
-	model.Name = basetypes.NewStringValue(entry.Name)
-
-	if entry.Path != "" {
-		model.Folder = basetypes.NewStringValue(entry.Path)
-	}
-
-	if entry.Description != "" {
-		model.Description = basetypes.NewStringValue(entry.Description)
-	}
-
-	if entry.Tags != nil {
-		var tagsBase []types.String
-
-		for _, v := range entry.Tags {
-			tagsBase = append(tagsBase, basetypes.NewStringValue(v))
-		}
-
-		model.Tags = tagsBase
-	}
-
-	if entry.Data != nil {
-		data, ok := entry.GetCredentialApiKeyData()
-		if ok {
-			if data.ApiId != "" {
-				model.ApiId = basetypes.NewStringValue(data.ApiId)
-			}
-
-			if data.ApiKey != "" {
-				model.ApiKey = basetypes.NewStringValue(data.ApiKey)
-			}
-
-			if data.TenantId != "" {
-				model.TenantId = basetypes.NewStringValue(data.TenantId)
-			}
-		}
-	}
+func NewEntryCredentialApiKeyDataSource() datasource.DataSource {
+	return newEntryCredentialDataSource(entryCredentialApiKeyType)
+}
 
-	*dsm = model
+func NewEntryCredentialApiKeyEphemeralResource() ephemeral.EphemeralResource {
+	return newEntryCredentialEphemeralResource(entryCredentialApiKeyType)
 }
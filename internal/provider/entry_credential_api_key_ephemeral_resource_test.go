@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccEntryCredentialApiKeyEphemeralResource_byId(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		CheckDestroy: testAccCheckEntryCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntryCredentialApiKeyEphemeralResourceConfig("tf_test_api_key_ephemeral", "tf_test_api_key_ephemeral"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("name"), knownvalue.StringExact("tf_test_api_key_ephemeral")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("api_id"), knownvalue.StringExact("test-api-id")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("api_key"), knownvalue.StringExact("test-api-key-secret")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("tenant_id"), knownvalue.StringExact("test-tenant-id")),
+				},
+			},
+		},
+	})
+}
+
+func testAccEntryCredentialApiKeyEphemeralResourceConfig(vaultName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "dvls_vault" "test" {
+  name = %[2]q
+}
+
+resource "dvls_entry_credential_api_key" "test" {
+  vault_id    = dvls_vault.test.id
+  name        = %[3]q
+  description = "test entry for ephemeral resource"
+  folder      = "tf_test_folder"
+  tags        = ["tf-test", "acceptance"]
+  api_id      = "test-api-id"
+  api_key     = "test-api-key-secret"
+  tenant_id   = "test-tenant-id"
+}
+
+ephemeral "dvls_entry_credential_api_key" "test" {
+  vault_id = dvls_vault.test.id
+  id       = dvls_entry_credential_api_key.test.id
+}
+
+provider "echo" {
+  data = ephemeral.dvls_entry_credential_api_key.test
+}
+
+resource "echo" "test" {}
+`, testAccProviderConfig(), vaultName, name)
+}
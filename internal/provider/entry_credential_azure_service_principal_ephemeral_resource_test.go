@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccEntryCredentialAzureServicePrincipalEphemeralResource_byId(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		CheckDestroy: testAccCheckEntryCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntryCredentialAzureServicePrincipalEphemeralResourceConfig("tf_test_azure_sp_ephemeral", "tf_test_azure_sp_ephemeral"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("name"), knownvalue.StringExact("tf_test_azure_sp_ephemeral")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("client_id"), knownvalue.StringExact("test-client-id")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("client_secret"), knownvalue.StringExact("test-client-secret")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("tenant_id"), knownvalue.StringExact("test-tenant-id")),
+				},
+			},
+		},
+	})
+}
+
+func testAccEntryCredentialAzureServicePrincipalEphemeralResourceConfig(vaultName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "dvls_vault" "test" {
+  name = %[2]q
+}
+
+resource "dvls_entry_credential_azure_service_principal" "test" {
+  vault_id      = dvls_vault.test.id
+  name          = %[3]q
+  description   = "test entry for ephemeral resource"
+  folder        = "tf_test_folder"
+  tags          = ["tf-test", "acceptance"]
+  client_id     = "test-client-id"
+  client_secret = "test-client-secret"
+  tenant_id     = "test-tenant-id"
+}
+
+ephemeral "dvls_entry_credential_azure_service_principal" "test" {
+  vault_id = dvls_vault.test.id
+  id       = dvls_entry_credential_azure_service_principal.test.id
+}
+
+provider "echo" {
+  data = ephemeral.dvls_entry_credential_azure_service_principal.test
+}
+
+resource "echo" "test" {}
+`, testAccProviderConfig(), vaultName, name)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccEntryCredentialConnectionStringEphemeralResource_byId(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		CheckDestroy: testAccCheckEntryCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntryCredentialConnectionStringEphemeralResourceConfig("tf_test_connection_string_ephemeral", "tf_test_connection_string_ephemeral"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("name"), knownvalue.StringExact("tf_test_connection_string_ephemeral")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("connection_string"), knownvalue.StringExact("Server=localhost;Database=testdb;User=sa;Password=test123")),
+				},
+			},
+		},
+	})
+}
+
+func testAccEntryCredentialConnectionStringEphemeralResourceConfig(vaultName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "dvls_vault" "test" {
+  name = %[2]q
+}
+
+resource "dvls_entry_credential_connection_string" "test" {
+  vault_id          = dvls_vault.test.id
+  name              = %[3]q
+  description       = "test entry for ephemeral resource"
+  folder            = "tf_test_folder"
+  tags              = ["tf-test", "acceptance"]
+  connection_string = "Server=localhost;Database=testdb;User=sa;Password=test123"
+}
+
+ephemeral "dvls_entry_credential_connection_string" "test" {
+  vault_id = dvls_vault.test.id
+  id       = dvls_entry_credential_connection_string.test.id
+}
+
+provider "echo" {
+  data = ephemeral.dvls_entry_credential_connection_string.test
+}
+
+resource "echo" "test" {}
+`, testAccProviderConfig(), vaultName, name)
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &entryCredentialDataSource[struct{}]{}
+var _ datasource.DataSourceWithConfigValidators = &entryCredentialDataSource[struct{}]{}
+
+func newEntryCredentialDataSource[T any](entryType entryCredentialType[T]) datasource.DataSource {
+	return &entryCredentialDataSource[T]{entryType: entryType}
+}
+
+// entryCredentialDataSource defines the data source implementation of a
+// credential entry subtype.
+type entryCredentialDataSource[T any] struct {
+	entryType entryCredentialType[T]
+	client    *dvlsClient
+}
+
+func (d *entryCredentialDataSource[T]) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.entryType.typeName
+}
+
+func (d *entryCredentialDataSource[T]) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the entry.",
+			Optional:    true,
+			Computed:    true,
+			Validators:  []validator.String{entryIdValidator{}},
+		},
+		"vault_id": schema.StringAttribute{
+			Description: "The ID of the vault.",
+			Required:    true,
+			Validators:  []validator.String{vaultIdValidator{}},
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the entry.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"folder": schema.StringAttribute{
+			Description: "The folder path to search in. Returns entries in the specified folder and all sub-folders.",
+			Optional:    true,
+			Computed:    true,
+			Validators:  []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("name"))},
+		},
+		"description": schema.StringAttribute{
+			Description: "The description of the entry.",
+			Computed:    true,
+		},
+		"tags": schema.ListAttribute{
+			ElementType: types.StringType,
+			Description: "A list of tags added to the entry.",
+			Computed:    true,
+		},
+	}
+
+	for _, f := range d.entryType.fields {
+		attributes[f.name] = schema.StringAttribute{
+			Description: f.description,
+			Computed:    true,
+			Sensitive:   f.sensitive,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: d.entryType.description(),
+		Attributes:  attributes,
+	}
+}
+
+func (d *entryCredentialDataSource[T]) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *entryCredentialDataSource[T]) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dvlsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.dvlsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *entryCredentialDataSource[T]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	data, diags := getEntryCredentialModel(ctx, req.Config.Get)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(readEntryCredential(ctx, d.client, d.entryType, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setEntryCredentialModel(ctx, resp.State.Set, data)...)
+}
+
+// readEntryCredential sets the model of a credential entry data source or
+// ephemeral resource to the entry targeted by its configuration.
+func readEntryCredential[T any](ctx context.Context, client *dvlsClient, entryType entryCredentialType[T], data entryCredentialModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	entry, err := fetchCredentialEntry(ctx, client, data.string("vault_id"), data.string("id"), data.string("name"), data.string("folder"), entryType.subType)
+	if err != nil {
+		if errors.Is(err, dvls.ErrMultipleEntriesFound) {
+			diags.AddError(
+				"multiple entries found",
+				fmt.Sprintf("more than one entry named %q found, use id to target the correct one", data.string("name").ValueString()),
+			)
+			return diags
+		}
+		addDvlsError(&diags, fmt.Sprintf("unable to read %s credential entry", entryType.label), err)
+		return diags
+	}
+
+	entryType.setModel(entry, data)
+
+	return diags
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &entryCredentialEphemeralResource[struct{}]{}
+var _ ephemeral.EphemeralResourceWithConfigure = &entryCredentialEphemeralResource[struct{}]{}
+var _ ephemeral.EphemeralResourceWithConfigValidators = &entryCredentialEphemeralResource[struct{}]{}
+
+func newEntryCredentialEphemeralResource[T any](entryType entryCredentialType[T]) ephemeral.EphemeralResource {
+	return &entryCredentialEphemeralResource[T]{entryType: entryType}
+}
+
+// entryCredentialEphemeralResource defines the ephemeral resource
+// implementation of a credential entry subtype.
+type entryCredentialEphemeralResource[T any] struct {
+	entryType entryCredentialType[T]
+	client    *dvlsClient
+}
+
+func (r *entryCredentialEphemeralResource[T]) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.entryType.typeName
+}
+
+func (r *entryCredentialEphemeralResource[T]) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the entry.",
+			Optional:    true,
+			Computed:    true,
+			Validators:  []validator.String{entryIdValidator{}},
+		},
+		"vault_id": schema.StringAttribute{
+			Description: "The ID of the vault.",
+			Required:    true,
+			Validators:  []validator.String{vaultIdValidator{}},
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the entry.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"folder": schema.StringAttribute{
+			Description: "The folder path to search in. Returns entries in the specified folder and all sub-folders.",
+			Optional:    true,
+			Computed:    true,
+			Validators:  []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("name"))},
+		},
+		"description": schema.StringAttribute{
+			Description: "The description of the entry.",
+			Computed:    true,
+		},
+		"tags": schema.ListAttribute{
+			ElementType: types.StringType,
+			Description: "A list of tags added to the entry.",
+			Computed:    true,
+		},
+	}
+
+	for _, f := range r.entryType.fields {
+		attributes[f.name] = schema.StringAttribute{
+			Description: f.description,
+			Computed:    true,
+			Sensitive:   f.sensitive,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: r.entryType.description() + ". Its values are never persisted to the Terraform plan or state.",
+		Attributes:  attributes,
+	}
+}
+
+func (r *entryCredentialEphemeralResource[T]) ConfigValidators(_ context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (r *entryCredentialEphemeralResource[T]) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dvlsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *provider.dvlsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *entryCredentialEphemeralResource[T]) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	data, diags := getEntryCredentialModel(ctx, req.Config.Get)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(readEntryCredential(ctx, r.client, r.entryType, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setEntryCredentialModel(ctx, resp.Result.Set, data)...)
+}
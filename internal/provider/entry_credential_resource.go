@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &entryCredentialResource[struct{}]{}
+var _ resource.ResourceWithImportState = &entryCredentialResource[struct{}]{}
+
+func newEntryCredentialResource[T any](entryType entryCredentialType[T]) resource.Resource {
+	return &entryCredentialResource[T]{entryType: entryType}
+}
+
+// entryCredentialResource defines the resource implementation of a credential
+// entry subtype.
+type entryCredentialResource[T any] struct {
+	entryType entryCredentialType[T]
+	client    *dvlsClient
+}
+
+func (r *entryCredentialResource[T]) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.entryType.typeName
+}
+
+func (r *entryCredentialResource[T]) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:   "The ID of the entry. This is set by the provider after creation.",
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"vault_id": schema.StringAttribute{
+			Description:   "The ID of the vault.",
+			Required:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the entry.",
+			Required:    true,
+		},
+		"folder": schema.StringAttribute{
+			Description: "The folder path where the entry is created.",
+			Optional:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "The description of the entry.",
+			Optional:    true,
+		},
+		"tags": schema.ListAttribute{
+			ElementType: types.StringType,
+			Description: "A list of tags to add to the entry.",
+			Optional:    true,
+		},
+	}
+
+	for _, f := range r.entryType.fields {
+		attributes[f.name] = schema.StringAttribute{
+			Description: f.description,
+			Optional:    true,
+			Sensitive:   f.sensitive,
+		}
+
+		if !f.writeOnly {
+			continue
+		}
+
+		attributes[f.writeOnlyName()] = schema.StringAttribute{
+			Description: f.writeOnlyDescription(),
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot(f.name)),
+				stringvalidator.AlsoRequires(path.MatchRoot(f.writeOnlyVersionName())),
+			},
+		}
+		attributes[f.writeOnlyVersionName()] = schema.Int64Attribute{
+			Description: f.writeOnlyVersionDescription(),
+			Optional:    true,
+			Validators:  []validator.Int64{int64validator.AlsoRequires(path.MatchRoot(f.writeOnlyName()))},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: r.entryType.description(),
+		Attributes:  attributes,
+	}
+}
+
+func (r *entryCredentialResource[T]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dvlsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.dvlsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *entryCredentialResource[T]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	plan, diags := getEntryCredentialModel(ctx, req.Plan.Get)
+	resp.Diagnostics.Append(diags...)
+	config, diags := getEntryCredentialModel(ctx, req.Config.Get)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setWriteOnlyValues(plan, config)
+
+	entry := r.entryType.newEntry(plan)
+
+	entryId, err := r.client.Entries.Credential.NewWithContext(ctx, entry)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, fmt.Sprintf("unable to create %s credential entry", r.entryType.label), err)
+		return
+	}
+
+	entry, err = r.client.Entries.Credential.GetByIdWithContext(ctx, entry.VaultId, entryId)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, fmt.Sprintf("unable to fetch created %s credential entry", r.entryType.label), err)
+		return
+	}
+
+	r.entryType.setModel(entry, plan)
+
+	resp.Diagnostics.Append(setEntryCredentialModel(ctx, resp.State.Set, plan)...)
+}
+
+func (r *entryCredentialResource[T]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Keep the current state until the provider configuration is known.
+	if !r.client.configured() {
+		return
+	}
+
+	state, diags := getEntryCredentialModel(ctx, req.State.Get)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, err := r.client.Entries.Credential.GetByIdWithContext(ctx, state.string("vault_id").ValueString(), state.string("id").ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addDvlsError(&resp.Diagnostics, fmt.Sprintf("unable to read %s credential entry", r.entryType.label), err)
+		return
+	}
+
+	r.entryType.setModel(entry, state)
+
+	resp.Diagnostics.Append(setEntryCredentialModel(ctx, resp.State.Set, state)...)
+}
+
+func (r *entryCredentialResource[T]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	plan, diags := getEntryCredentialModel(ctx, req.Plan.Get)
+	resp.Diagnostics.Append(diags...)
+	config, diags := getEntryCredentialModel(ctx, req.Config.Get)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setWriteOnlyValues(plan, config)
+
+	entry, err := r.client.Entries.Credential.UpdateWithContext(ctx, r.entryType.newEntry(plan))
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, fmt.Sprintf("unable to update %s credential entry", r.entryType.label), err)
+		return
+	}
+
+	r.entryType.setModel(entry, plan)
+
+	resp.Diagnostics.Append(setEntryCredentialModel(ctx, resp.State.Set, plan)...)
+}
+
+func (r *entryCredentialResource[T]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	state, diags := getEntryCredentialModel(ctx, req.State.Get)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Entries.Credential.DeleteWithContext(ctx, r.entryType.newEntry(state))
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addDvlsError(&resp.Diagnostics, fmt.Sprintf("unable to delete %s credential entry", r.entryType.label), err)
+		return
+	}
+}
+
+func (r *entryCredentialResource[T]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	vaultId, entryId, err := parseEntryImportId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Resource ID", err.Error())
+		return
+	}
+
+	entry, err := r.client.Entries.Credential.GetByIdWithContext(ctx, vaultId, entryId)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to read entry", err)
+		return
+	}
+
+	if err := checkCredentialSubType(entry, r.entryType.subType); err != nil {
+		resp.Diagnostics.AddError("invalid entry type", err.Error())
+		return
+	}
+
+	resp.State.SetAttribute(ctx, path.Root("vault_id"), vaultId)
+	resp.State.SetAttribute(ctx, path.Root("id"), entryId)
+}
+
+// setWriteOnlyValues copies the values of the write-only attributes, which are
+// never part of the plan, from the configuration.
+func (r *entryCredentialResource[T]) setWriteOnlyValues(plan, config entryCredentialModel) {
+	for _, f := range r.entryType.fields {
+		if f.writeOnly {
+			plan.values[f.writeOnlyName()] = config.values[f.writeOnlyName()]
+		}
+	}
+}
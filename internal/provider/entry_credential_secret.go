@@ -2,104 +2,36 @@ package provider
 
 import (
 	"github.com/Devolutions/go-dvls"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
-func newEntryCredentialSecretFromResourceModel(rm *EntryCredentialSecretResourceModel) dvls.Entry {
-	var tags []string
-
-	for _, v := range rm.Tags {
-		tags = append(tags, v.ValueString())
-	}
-
-	entryCredentialSecret := dvls.Entry{
-		Id:          rm.Id.ValueString(),
-		VaultId:     rm.VaultId.ValueString(),
-		Name:        rm.Name.ValueString(),
-		Path:        rm.Folder.ValueString(),
-		Type:        dvls.EntryCredentialType,
-		SubType:     dvls.EntryCredentialSubTypeAccessCode,
-		Description: rm.Description.ValueString(),
-		Tags:        tags,
-		Data: dvls.EntryCredentialAccessCodeData{
-			Password: rm.Secret.ValueString(),
+var entryCredentialSecretType = entryCredentialType[dvls.EntryCredentialAccessCodeData]{
+	typeName: "entry_credential_secret",
+	subType:  dvls.EntryCredentialSubTypeAccessCode,
+	title:    "Secret",
+	label:    "secret",
+	fields: []entryCredentialField[dvls.EntryCredentialAccessCodeData]{
+		{
+			name:        "secret",
+			description: "The entry credential secret.",
+			sensitive:   true,
+			writeOnly:   true,
+			noun:        "secret",
+			value:       func(data *dvls.EntryCredentialAccessCodeData) *string { return &data.Password },
 		},
-	}
-
-	return entryCredentialSecret
+	},
 }
 
-func setEntryCredentialSecretResourceModel(entry dvls.Entry, rm *EntryCredentialSecretResourceModel) {
-	var model EntryCredentialSecretResourceModel
-
-	model.Id = basetypes.NewStringValue(entry.Id)
-	model.VaultId = basetypes.NewStringValue(entry.VaultId)
-	model.Name = basetypes.NewStringValue(entry.Name)
-
-	if entry.Path != "" {
-		model.Folder = basetypes.NewStringValue(entry.Path)
-	}
-
-	if entry.Description != "" {
-		model.Description = basetypes.NewStringValue(entry.Description)
-	}
-
-	if entry.Tags != nil {
-		var tagsBase []types.String
-
-		for _, v := range entry.Tags {
-			tagsBase = append(tagsBase, basetypes.NewStringValue(v))
-		}
-
-		model.Tags = tagsBase
-	}
-
-	if entry.Data != nil {
-		data, ok := entry.GetCredentialAccessCodeData()
-		if ok {
-			if data.Password != "" {
-				model.Secret = basetypes.NewStringValue(data.Password)
-			}
-		}
-	}
-
-	*rm = model
+func NewEntryCredentialSecretResource() resource.Resource {
+	return newEntryCredentialResource(entryCredentialSecretType)
 }
 
-func setEntryCredentialSecretDataModel(entry dvls.Entry, dsm *EntryCredentialSecretDataSourceModel) {
-	var model EntryCredentialSecretDataSourceModel
-
-	model.Id = basetypes.NewStringValue(entry.Id)
-	model.VaultId = basetypes.NewStringValue(entry.VaultId)
-	model.Name = basetypes.NewStringValue(entry.Name)
-
-	if entry.Path != "" {
-		model.Folder = basetypes.NewStringValue(entry.Path)
-	}
-
-	if entry.Description != "" {
-		model.Description = basetypes.NewStringValue(entry.Description)
-	}
-
-	if entry.Tags != nil {
-		var tagsBase []types.String
-
-		for _, v := range entry.Tags {
-			tagsBase = append(tagsBase, basetypes.NewStringValue(v))
-		}
-
-		model.Tags = tagsBase
-	}
-
-	if entry.Data != nil {
-		data, ok := entry.GetCredentialAccessCodeData()
-		if ok {
-			if data.Password != "" {
-				model.Secret = basetypes.NewStringValue(data.Password)
-			}
-		}
-	}
+func NewEntryCredentialSecretDataSource() datasource.DataSource {
+	return newEntryCredentialDataSource(entryCredentialSecretType)
+}
 
-	*dsm = model
+func NewEntryCredentialSecretEphemeralResource() ephemeral.EphemeralResource {
+	return newEntryCredentialEphemeralResource(entryCredentialSecretType)
 }
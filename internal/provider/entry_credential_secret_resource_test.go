@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccEntryCredentialSecretResource_basic(t *testing.T) {
@@ -72,3 +74,78 @@ resource "dvls_entry_credential_secret" "test" {
 }
 `, testAccProviderConfig(), vaultName, name, description, folder, secret)
 }
+
+func TestAccEntryCredentialSecretResource_writeOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckEntryCredentialDestroy,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: testAccEntryCredentialSecretResourceConfig_writeOnly("tf_test_secret_wo", "tf_test_secret_wo", "my-secret-value-123", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("dvls_entry_credential_secret.test", "secret"),
+					resource.TestCheckNoResourceAttr("dvls_entry_credential_secret.test", "secret_wo"),
+					resource.TestCheckResourceAttr("dvls_entry_credential_secret.test", "secret_wo_version", "1"),
+					testAccCheckEntryCredentialSecretStoredValue("dvls_entry_credential_secret.test", "my-secret-value-123"),
+				),
+			},
+			// Rotate
+			{
+				Config: testAccEntryCredentialSecretResourceConfig_writeOnly("tf_test_secret_wo", "tf_test_secret_wo", "updated-secret-value-456", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("dvls_entry_credential_secret.test", "secret"),
+					resource.TestCheckResourceAttr("dvls_entry_credential_secret.test", "secret_wo_version", "2"),
+					testAccCheckEntryCredentialSecretStoredValue("dvls_entry_credential_secret.test", "updated-secret-value-456"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEntryCredentialSecretStoredValue(resourceName, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		client, err := getTestAccClient()
+		if err != nil {
+			return err
+		}
+
+		entry, err := client.Entries.Credential.GetById(rs.Primary.Attributes["vault_id"], rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to read entry %s: %s", rs.Primary.ID, err)
+		}
+
+		data, ok := entry.GetCredentialAccessCodeData()
+		if !ok || data.Password != expected {
+			return fmt.Errorf("entry %s does not hold the expected secret", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEntryCredentialSecretResourceConfig_writeOnly(vaultName, name, secret string, secretVersion int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "dvls_vault" "test" {
+  name = %[2]q
+}
+
+resource "dvls_entry_credential_secret" "test" {
+  vault_id          = dvls_vault.test.id
+  name              = %[3]q
+  secret_wo         = %[4]q
+  secret_wo_version = %[5]d
+}
+`, testAccProviderConfig(), vaultName, name, secret, secretVersion)
+}
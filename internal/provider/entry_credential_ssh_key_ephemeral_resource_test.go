@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccEntryCredentialSSHKeyEphemeralResource_byId(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		CheckDestroy: testAccCheckEntryCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntryCredentialSSHKeyEphemeralResourceConfig("tf_test_ssh_key_ephemeral", "tf_test_ssh_key_ephemeral"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("name"), knownvalue.StringExact("tf_test_ssh_key_ephemeral")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("username"), knownvalue.StringExact("testuser")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("password"), knownvalue.StringExact("testpassword")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("passphrase"), knownvalue.StringExact("testpassphrase")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("private_key_data"), knownvalue.StringExact("test-private-key-data")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("public_key"), knownvalue.StringExact("test-public-key")),
+				},
+			},
+		},
+	})
+}
+
+func testAccEntryCredentialSSHKeyEphemeralResourceConfig(vaultName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "dvls_vault" "test" {
+  name = %[2]q
+}
+
+resource "dvls_entry_credential_ssh_key" "test" {
+  vault_id         = dvls_vault.test.id
+  name             = %[3]q
+  description      = "test entry for ephemeral resource"
+  folder           = "tf_test_folder"
+  tags             = ["tf-test", "acceptance"]
+  username         = "testuser"
+  password         = "testpassword"
+  passphrase       = "testpassphrase"
+  private_key_data = "test-private-key-data"
+  public_key       = "test-public-key"
+}
+
+ephemeral "dvls_entry_credential_ssh_key" "test" {
+  vault_id = dvls_vault.test.id
+  id       = dvls_entry_credential_ssh_key.test.id
+}
+
+provider "echo" {
+  data = ephemeral.dvls_entry_credential_ssh_key.test
+}
+
+resource "echo" "test" {}
+`, testAccProviderConfig(), vaultName, name)
+}
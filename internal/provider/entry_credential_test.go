@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEntryCredentialType_resourceModel(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	NewEntryCredentialSSHKeyResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	attributes["vault_id"] = tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001")
+	attributes["name"] = tftypes.NewValue(tftypes.String, "ssh")
+	attributes["tags"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "prod")})
+	attributes["username"] = tftypes.NewValue(tftypes.String, "admin")
+	attributes["password_wo"] = tftypes.NewValue(tftypes.String, "write-only")
+	attributes["password_wo_version"] = tftypes.NewValue(tftypes.Number, 1)
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, attributes),
+	}
+
+	model, diags := getEntryCredentialModel(ctx, plan.Get)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	entry := entryCredentialSSHKeyType.newEntry(model)
+
+	if entry.SubType != dvls.EntryCredentialSubTypePrivateKey || entry.Name != "ssh" || len(entry.Tags) != 1 || entry.Tags[0] != "prod" {
+		t.Fatalf("unexpected entry %+v", entry)
+	}
+
+	data, ok := entry.Data.(*dvls.EntryCredentialPrivateKeyData)
+	if !ok || data.Username != "admin" || data.Password != "write-only" {
+		t.Fatalf("unexpected entry data %+v", entry.Data)
+	}
+
+	entry.Id = "00000000-0000-0000-0000-000000000002"
+	entry.Data = &dvls.EntryCredentialPrivateKeyData{
+		Username:  "admin",
+		Password:  "write-only",
+		PublicKey: "ssh-ed25519 AAAA",
+	}
+
+	entryCredentialSSHKeyType.setModel(entry, model)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := setEntryCredentialModel(ctx, state.Set, model); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	tests := map[string]types.String{
+		"id":          types.StringValue("00000000-0000-0000-0000-000000000002"),
+		"username":    types.StringValue("admin"),
+		"public_key":  types.StringValue("ssh-ed25519 AAAA"),
+		"password":    types.StringNull(),
+		"password_wo": types.StringNull(),
+		"passphrase":  types.StringNull(),
+		"folder":      types.StringNull(),
+	}
+
+	for name, want := range tests {
+		var got types.String
+		state.GetAttribute(ctx, path.Root(name), &got)
+
+		if !got.Equal(want) {
+			t.Errorf("expected %s to be %s, got %s", name, want, got)
+		}
+	}
+
+	var version types.Int64
+	state.GetAttribute(ctx, path.Root("password_wo_version"), &version)
+
+	if version.ValueInt64() != 1 {
+		t.Errorf("expected password_wo_version to be kept, got %s", version)
+	}
+}
+
+func TestEntryCredentialType_dataSourceModel(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp datasource.SchemaResponse
+	NewEntryCredentialApiKeyDataSource().Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	attributes["vault_id"] = tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001")
+	attributes["name"] = tftypes.NewValue(tftypes.String, "api")
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, attributes),
+	}
+
+	model, diags := getEntryCredentialModel(ctx, config.Get)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	entryCredentialApiKeyType.setModel(dvls.Entry{
+		Id:      "00000000-0000-0000-0000-000000000002",
+		VaultId: "00000000-0000-0000-0000-000000000001",
+		Name:    "api",
+		Tags:    []string{"prod"},
+		Data:    &dvls.EntryCredentialApiKeyData{ApiId: "id", ApiKey: "key"},
+	}, model)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := setEntryCredentialModel(ctx, state.Set, model); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var apiKey, tenantId types.String
+	state.GetAttribute(ctx, path.Root("api_key"), &apiKey)
+	state.GetAttribute(ctx, path.Root("tenant_id"), &tenantId)
+
+	if apiKey.ValueString() != "key" {
+		t.Errorf("expected api_key to be set, got %s", apiKey)
+	}
+
+	if !tenantId.IsNull() {
+		t.Errorf("expected tenant_id to be null, got %s", tenantId)
+	}
+}
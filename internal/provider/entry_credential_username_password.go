@@ -2,122 +2,46 @@ package provider
 
 import (
 	"github.com/Devolutions/go-dvls"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
-func newEntryCredentialUsernamePasswordFromResourceModel(rm *EntryCredentialUsernamePasswordResourceModel) dvls.Entry {
-	var tags []string
-
-	for _, v := range rm.Tags {
-		tags = append(tags, v.ValueString())
-	}
-
-	entryCredentialUsernamePassword := dvls.Entry{
-		Id:          rm.Id.ValueString(),
-		VaultId:     rm.VaultId.ValueString(),
-		Name:        rm.Name.ValueString(),
-		Path:        rm.Folder.ValueString(),
-		Type:        dvls.EntryCredentialType,
-		SubType:     dvls.EntryCredentialSubTypeDefault,
-		Description: rm.Description.ValueString(),
-		Tags:        tags,
-		Data: dvls.EntryCredentialDefaultData{
-			Username: rm.Username.ValueString(),
-			Domain:   rm.Domain.ValueString(),
-			Password: rm.Password.ValueString(),
+var entryCredentialUsernamePasswordType = entryCredentialType[dvls.EntryCredentialDefaultData]{
+	typeName: "entry_credential_username_password",
+	subType:  dvls.EntryCredentialSubTypeDefault,
+	title:    "Username and Password",
+	label:    "username password",
+	fields: []entryCredentialField[dvls.EntryCredentialDefaultData]{
+		{
+			name:        "username",
+			description: "The entry credential username.",
+			value:       func(data *dvls.EntryCredentialDefaultData) *string { return &data.Username },
 		},
-	}
-
-	return entryCredentialUsernamePassword
+		{
+			name:        "domain",
+			description: "The entry credential domain.",
+			value:       func(data *dvls.EntryCredentialDefaultData) *string { return &data.Domain },
+		},
+		{
+			name:        "password",
+			description: "The entry credential password.",
+			sensitive:   true,
+			writeOnly:   true,
+			noun:        "password",
+			value:       func(data *dvls.EntryCredentialDefaultData) *string { return &data.Password },
+		},
+	},
 }
 
-func setEntryCredentialUsernamePasswordResourceModel(entry dvls.Entry, rm *EntryCredentialUsernamePasswordResourceModel) {
-	var model EntryCredentialUsernamePasswordResourceModel
-
-	model.Id = basetypes.NewStringValue(entry.Id)
-	model.VaultId = basetypes.NewStringValue(entry.VaultId)
-	model.Name = basetypes.NewStringValue(entry.Name)
-
-	if entry.Path != "" {
-		model.Folder = basetypes.NewStringValue(entry.Path)
-	}
-
-	if entry.Description != "" {
-		model.Description = basetypes.NewStringValue(entry.Description)
-	}
-
-	if entry.Tags != nil {
-		var tagsBase []types.String
-
-		for _, v := range entry.Tags {
-			tagsBase = append(tagsBase, basetypes.NewStringValue(v))
-		}
-
-		model.Tags = tagsBase
-	}
-
-	if entry.Data != nil {
-		data, ok := entry.GetCredentialDefaultData()
-		if ok {
-			if data.Username != "" {
-				model.Username = basetypes.NewStringValue(data.Username)
-			}
-
-			if data.Domain != "" {
-				model.Domain = basetypes.NewStringValue(data.Domain)
-			}
-
-			if data.Password != "" {
-				model.Password = basetypes.NewStringValue(data.Password)
-			}
-		}
-	}
-
-	*rm = model
+func NewEntryCredentialUsernamePasswordResource() resource.Resource {
+	return newEntryCredentialResource(entryCredentialUsernamePasswordType)
 }
 
-func setEntryCredentialUsernamePasswordDataModel(entry dvls.Entry, dsm *EntryCredentialUsernamePasswordDataSourceModel) {
-	var model EntryCredentialUsernamePasswordDataSourceModel
-
-	model.Id = basetypes.NewStringValue(entry.Id)
-	model.VaultId = basetypes.NewStringValue(entry.VaultId)
-	model.Name = basetypes.NewStringValue(entry.Name)
-
-	if entry.Path != "" {
-		model.Folder = basetypes.NewStringValue(entry.Path)
-	}
-
-	if entry.Description != "" {
-		model.Description = basetypes.NewStringValue(entry.Description)
-	}
-
-	if entry.Tags != nil {
-		var tagsBase []types.String
-
-		for _, v := range entry.Tags {
-			tagsBase = append(tagsBase, basetypes.NewStringValue(v))
-		}
-
-		model.Tags = tagsBase
-	}
-
-	if entry.Data != nil {
-		data, ok := entry.GetCredentialDefaultData()
-		if ok {
-			if data.Username != "" {
-				model.Username = basetypes.NewStringValue(data.Username)
-			}
-
-			if data.Domain != "" {
-				model.Domain = basetypes.NewStringValue(data.Domain)
-			}
-
-			if data.Password != "" {
-				model.Password = basetypes.NewStringValue(data.Password)
-			}
-		}
-	}
+func NewEntryCredentialUsernamePasswordDataSource() datasource.DataSource {
+	return newEntryCredentialDataSource(entryCredentialUsernamePasswordType)
+}
 
-	*dsm = model
+func NewEntryCredentialUsernamePasswordEphemeralResource() ephemeral.EphemeralResource {
+	return newEntryCredentialEphemeralResource(entryCredentialUsernamePasswordType)
 }
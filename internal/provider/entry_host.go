@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func newEntryHostFromResourceModel(rm *EntryHostResourceModel) dvls.EntryHost {
+	var tags []string
+
+	for _, v := range rm.Tags {
+		tags = append(tags, v.ValueString())
+	}
+
+	var password *string
+	if !rm.Password.IsNull() {
+		password = rm.Password.ValueStringPointer()
+	}
+
+	entryHost := dvls.EntryHost{
+		Id:              rm.Id.ValueString(),
+		VaultId:         rm.VaultId.ValueString(),
+		EntryName:       rm.Name.ValueString(),
+		EntryFolderPath: rm.Folder.ValueString(),
+		Description:     rm.Description.ValueString(),
+		ConnectionType:  dvls.ServerConnectionHost,
+		Tags:            tags,
+		HostDetails: dvls.EntryHostAuthDetails{
+			Host:     rm.Host.ValueString(),
+			Username: rm.Username.ValueString(),
+			Password: password,
+		},
+	}
+
+	return entryHost
+}
+
+func setEntryHostResourceModel(entryHost dvls.EntryHost, rm *EntryHostResourceModel) {
+	var model EntryHostResourceModel
+
+	model.Id = basetypes.NewStringValue(entryHost.Id)
+	model.VaultId = basetypes.NewStringValue(entryHost.VaultId)
+	model.Name = basetypes.NewStringValue(entryHost.EntryName)
+
+	if entryHost.EntryFolderPath != "" {
+		model.Folder = basetypes.NewStringValue(entryHost.EntryFolderPath)
+	}
+
+	if entryHost.Description != "" {
+		model.Description = basetypes.NewStringValue(entryHost.Description)
+	}
+
+	if entryHost.Tags != nil {
+		var tagsBase []types.String
+
+		for _, v := range entryHost.Tags {
+			tagsBase = append(tagsBase, basetypes.NewStringValue(v))
+		}
+
+		model.Tags = tagsBase
+	}
+
+	if entryHost.HostDetails.Host != "" {
+		model.Host = basetypes.NewStringValue(entryHost.HostDetails.Host)
+	}
+
+	if entryHost.HostDetails.Username != "" {
+		model.Username = basetypes.NewStringValue(entryHost.HostDetails.Username)
+	}
+
+	if entryHost.HostDetails.Password != nil && *entryHost.HostDetails.Password != "" {
+		model.Password = basetypes.NewStringValue(*entryHost.HostDetails.Password)
+	}
+
+	*rm = model
+}
+
+// fetchEntryHost returns the host entry specified by id, including its password.
+func fetchEntryHost(ctx context.Context, client *dvlsClient, id string) (dvls.EntryHost, error) {
+	entryHost, err := client.Entries.Host.GetWithContext(ctx, id)
+	if err != nil {
+		return dvls.EntryHost{}, err
+	}
+
+	return client.Entries.Host.GetHostDetailsWithContext(ctx, entryHost)
+}
@@ -2,17 +2,22 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &EntryHostDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &EntryHostDataSource{}
 
 func NewEntryHostDataSource() datasource.DataSource {
 	return &EntryHostDataSource{}
@@ -20,7 +25,7 @@ func NewEntryHostDataSource() datasource.DataSource {
 
 // EntryHostDataSource defines the resource implementation.
 type EntryHostDataSource struct {
-	client *dvls.Client
+	client *dvlsClient
 }
 
 // EntryHostDataSourceModel describes the resource data model.
@@ -48,21 +53,28 @@ func (d *EntryHostDataSource) Schema(ctx context.Context, req datasource.SchemaR
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "User Credential ID",
-				Required:    true,
+				Description: "Host ID. Either id or name must be specified.",
+				Optional:    true,
+				Computed:    true,
 				Validators:  []validator.String{entryIdValidator{}},
 			},
 			"vault_id": schema.StringAttribute{
-				Description: "Vault ID",
+				Description: "Vault ID. Required when looking up the host by name.",
+				Optional:    true,
 				Computed:    true,
+				Validators:  []validator.String{vaultIdValidator{}},
 			},
 			"name": schema.StringAttribute{
-				Description: "Host name",
+				Description: "Host name. Either id or name must be specified.",
+				Optional:    true,
 				Computed:    true,
+				Validators:  []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("vault_id"))},
 			},
 			"folder": schema.StringAttribute{
-				Description: "Host folder path",
+				Description: "Host folder path. When looking up the host by name, returns entries in the specified folder and all sub-folders.",
+				Optional:    true,
 				Computed:    true,
+				Validators:  []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("name"))},
 			},
 			"description": schema.StringAttribute{
 				Description: "Host description",
@@ -90,18 +102,27 @@ func (d *EntryHostDataSource) Schema(ctx context.Context, req datasource.SchemaR
 	}
 }
 
+func (d *EntryHostDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *EntryHostDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*dvls.Client)
+	client, ok := req.ProviderData.(*dvlsClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *dvls.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.dvlsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -111,6 +132,11 @@ func (d *EntryHostDataSource) Configure(ctx context.Context, req datasource.Conf
 }
 
 func (d *EntryHostDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
 	var data EntryHostDataSourceModel
 
 	diags := req.Config.Get(ctx, &data)
@@ -119,21 +145,28 @@ func (d *EntryHostDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	entryHost, err := d.client.Entries.Host.Get(data.Id.ValueString())
+	entryHostId, err := fetchLegacyEntryId(ctx, d.client, data.VaultId, data.Id, data.Name, data.Folder, dvls.ServerConnectionHost, "")
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Host Entry",
-			err.Error(),
-		)
+		if errors.Is(err, dvls.ErrMultipleEntriesFound) {
+			resp.Diagnostics.AddError(
+				"multiple entries found",
+				fmt.Sprintf("more than one host entry named %q found, use id to target the correct one", data.Name.ValueString()),
+			)
+			return
+		}
+		addDvlsError(&resp.Diagnostics, "unable to read host entry", err)
 		return
 	}
 
-	entryHostSensitiveData, err := d.client.Entries.Host.GetHostDetails(entryHost)
+	entryHost, err := d.client.Entries.Host.GetWithContext(ctx, entryHostId)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Host Entry Sensitive Data",
-			err.Error(),
-		)
+		addDvlsError(&resp.Diagnostics, "unable to read host entry", err)
+		return
+	}
+
+	entryHostSensitiveData, err := d.client.Entries.Host.GetHostDetailsWithContext(ctx, entryHost)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to read host entry sensitive data", err)
 		return
 	}
 
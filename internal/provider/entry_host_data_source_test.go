@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEntryHostDataSource_byName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEntryHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntryHostDataSourceConfig_byName("tf_test_host_by_name", "tf_test_host_by_name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "id", "dvls_entry_host.test", "id"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "vault_id", "dvls_entry_host.test", "vault_id"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "name", "dvls_entry_host.test", "name"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "description", "dvls_entry_host.test", "description"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "folder", "dvls_entry_host.test", "folder"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "tags.#", "dvls_entry_host.test", "tags.#"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "host", "dvls_entry_host.test", "host"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "username", "dvls_entry_host.test", "username"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "password", "dvls_entry_host.test", "password"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEntryHostDataSource_byId(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEntryHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntryHostDataSourceConfig_byId("tf_test_host_by_id", "tf_test_host_by_id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "id", "dvls_entry_host.test", "id"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "vault_id", "dvls_entry_host.test", "vault_id"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "name", "dvls_entry_host.test", "name"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "description", "dvls_entry_host.test", "description"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "folder", "dvls_entry_host.test", "folder"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "tags.#", "dvls_entry_host.test", "tags.#"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "host", "dvls_entry_host.test", "host"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "username", "dvls_entry_host.test", "username"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_host.test", "password", "dvls_entry_host.test", "password"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEntryHostDataSourceConfig_byName(vaultName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "dvls_vault" "test" {
+  name = %[2]q
+}
+
+resource "dvls_entry_host" "test" {
+  vault_id    = dvls_vault.test.id
+  name        = %[3]q
+  description = "test entry for data source"
+  folder      = "tf_test_folder"
+  tags        = ["tf-test", "acceptance"]
+  host        = "host01.example.com"
+  username    = "testuser"
+  password    = "testpassword123"
+}
+
+data "dvls_entry_host" "test" {
+  vault_id = dvls_vault.test.id
+  name     = dvls_entry_host.test.name
+  folder   = "tf_test_folder"
+}
+`, testAccProviderConfig(), vaultName, name)
+}
+
+func testAccEntryHostDataSourceConfig_byId(vaultName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "dvls_vault" "test" {
+  name = %[2]q
+}
+
+resource "dvls_entry_host" "test" {
+  vault_id    = dvls_vault.test.id
+  name        = %[3]q
+  description = "test entry for data source"
+  folder      = "tf_test_folder"
+  tags        = ["tf-test", "acceptance"]
+  host        = "host01.example.com"
+  username    = "testuser"
+  password    = "testpassword123"
+}
+
+data "dvls_entry_host" "test" {
+  id = dvls_entry_host.test.id
+}
+`, testAccProviderConfig(), vaultName, name)
+}
@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EntryHostResource{}
+var _ resource.ResourceWithImportState = &EntryHostResource{}
+
+func NewEntryHostResource() resource.Resource {
+	return &EntryHostResource{}
+}
+
+// EntryHostResource defines the resource implementation.
+type EntryHostResource struct {
+	client *dvlsClient
+}
+
+// EntryHostResourceModel describes the resource data model.
+type EntryHostResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	VaultId     types.String   `tfsdk:"vault_id"`
+	Name        types.String   `tfsdk:"name"`
+	Folder      types.String   `tfsdk:"folder"`
+	Description types.String   `tfsdk:"description"`
+	Tags        []types.String `tfsdk:"tags"`
+
+	// General
+	Host     types.String `tfsdk:"host"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+func (r *EntryHostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entry_host"
+}
+
+func (r *EntryHostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A DVLS Host Entry",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "The ID of the entry. This is set by the provider after creation.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"vault_id": schema.StringAttribute{
+				Description:   "The ID of the vault.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the entry.",
+				Required:    true,
+			},
+			"folder": schema.StringAttribute{
+				Description: "The folder path where the entry is created.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the entry.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "A list of tags to add to the entry.",
+				Optional:    true,
+			},
+			"host": schema.StringAttribute{
+				Description: "The host name or IP address.",
+				Required:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "The host username.",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The host password.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *EntryHostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dvlsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.dvlsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EntryHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	var plan *EntryHostResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entryHost := newEntryHostFromResourceModel(plan)
+
+	entryHostId, err := r.client.saveEntry(ctx, http.MethodPost, entryHost)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to create host entry", err)
+		return
+	}
+
+	entryHost, err = fetchEntryHost(ctx, r.client, entryHostId)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to fetch created host entry", err)
+		return
+	}
+
+	setEntryHostResourceModel(entryHost, plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *EntryHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Keep the current state until the provider configuration is known.
+	if !r.client.configured() {
+		return
+	}
+
+	var state *EntryHostResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entryHost, err := fetchEntryHost(ctx, r.client, state.Id.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addDvlsError(&resp.Diagnostics, "unable to read host entry", err)
+		return
+	}
+
+	setEntryHostResourceModel(entryHost, state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *EntryHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	var plan *EntryHostResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entryHost := newEntryHostFromResourceModel(plan)
+
+	_, err := r.client.saveEntry(ctx, http.MethodPut, entryHost)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to update host entry", err)
+		return
+	}
+
+	entryHost, err = fetchEntryHost(ctx, r.client, entryHost.Id)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to fetch updated host entry", err)
+		return
+	}
+
+	setEntryHostResourceModel(entryHost, plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *EntryHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	var state *EntryHostResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.deleteEntry(ctx, state.Id.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addDvlsError(&resp.Diagnostics, "unable to delete host entry", err)
+		return
+	}
+}
+
+func (r *EntryHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	vaultId, entryId, err := parseEntryImportId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Resource ID", err.Error())
+		return
+	}
+
+	entryHost, err := r.client.Entries.Host.GetWithContext(ctx, entryId)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to read entry", err)
+		return
+	}
+
+	if entryHost.ConnectionType != dvls.ServerConnectionHost {
+		resp.Diagnostics.AddError("invalid entry type", "expected a host entry.")
+		return
+	}
+
+	if entryHost.VaultId != vaultId {
+		resp.Diagnostics.AddError("invalid vault id", fmt.Sprintf("entry %s does not belong to vault %s.", entryId, vaultId))
+		return
+	}
+
+	resp.State.SetAttribute(ctx, path.Root("vault_id"), vaultId)
+	resp.State.SetAttribute(ctx, path.Root("id"), entryId)
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccEntryHostResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEntryHostDestroy,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: testAccEntryHostResourceConfig(
+					"tf_test_host", "tf_test_host", "test description", "tf_test_folder",
+					"host01.example.com", "testuser", "testpassword123",
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dvls_entry_host.test", "id"),
+					resource.TestCheckResourceAttrPair("dvls_entry_host.test", "vault_id", "dvls_vault.test", "id"),
+					resource.TestCheckResourceAttr("dvls_entry_host.test", "name", "tf_test_host"),
+					resource.TestCheckResourceAttr("dvls_entry_host.test", "description", "test description"),
+					resource.TestCheckResourceAttr("dvls_entry_host.test", "folder", "tf_test_folder"),
+					resource.TestCheckResourceAttr("dvls_entry_host.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("dvls_entry_host.test", "tags.0", "tf-test"),
+					resource.TestCheckResourceAttr("dvls_entry_host.test", "tags.1", "acceptance"),
+					resource.TestCheckResourceAttr("dvls_entry_host.test", "host", "host01.example.com"),
+					resource.TestCheckResourceAttr("dvls_entry_host.test", "username", "testuser"),
+					resource.TestCheckResourceAttr("dvls_entry_host.test", "password", "testpassword123"),
+				),
+			},
+			// Update
+			{
+				Config: testAccEntryHostResourceConfig(
+					"tf_test_host", "tf_test_host_updated", "updated description", "tf_test_folder_updated",
+					"host02.example.com", "updateduser", "updatedpassword456",
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dvls_entry_host.test", "name", "tf_test_host_updated"),
+					resource.TestCheckResourceAttr("dvls_entry_host.test", "description", "updated description"),
+					resource.TestCheckResourceAttr("dvls_entry_host.test", "folder", "tf_test_folder_updated"),
+					resource.TestCheckResourceAttr("dvls_entry_host.test", "host", "host02.example.com"),
+					resource.TestCheckResourceAttr("dvls_entry_host.test", "username", "updateduser"),
+					resource.TestCheckResourceAttr("dvls_entry_host.test", "password", "updatedpassword456"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "dvls_entry_host.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccEntryCredentialImportStateIdFunc("dvls_entry_host.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEntryHostDestroy(s *terraform.State) error {
+	client, err := getTestAccClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dvls_entry_host" {
+			continue
+		}
+
+		_, err := client.Entries.Host.Get(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("entry %s still exists", rs.Primary.ID)
+		}
+
+		if !isNotFound(err) {
+			return fmt.Errorf("unexpected error checking entry %s: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccEntryHostResourceConfig(vaultName, name, description, folder, host, username, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "dvls_vault" "test" {
+  name = %[2]q
+}
+
+resource "dvls_entry_host" "test" {
+  vault_id    = dvls_vault.test.id
+  name        = %[3]q
+  description = %[4]q
+  folder      = %[5]q
+  tags        = ["tf-test", "acceptance"]
+  host        = %[6]q
+  username    = %[7]q
+  password    = %[8]q
+}
+`, testAccProviderConfig(), vaultName, name, description, folder, host, username, password)
+}
@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fetchLegacyEntryId returns id when it is set. Otherwise, it looks up the
+// entry of the given connection type by name and folder in the vault.
+func fetchLegacyEntryId(ctx context.Context, client *dvlsClient, vaultId, id, name, folder types.String, connectionType dvls.ServerConnectionType, connectionSubType dvls.ServerConnectionSubType) (string, error) {
+	if !id.IsNull() && !id.IsUnknown() {
+		return id.ValueString(), nil
+	}
+
+	var folderPath *string
+	if !folder.IsNull() && !folder.IsUnknown() {
+		v := folder.ValueString()
+		folderPath = &v
+	}
+
+	return client.findLegacyEntryId(ctx, vaultId.ValueString(), name.ValueString(), folderPath, connectionType, connectionSubType)
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// websiteBrowserApplications maps the DVLS WebBrowserApplication values to
+// their Terraform names.
+var websiteBrowserApplications map[int]string = map[int]string{
+	0: "default",
+	1: "internet_explorer",
+	2: "firefox",
+	3: "google_chrome",
+	4: "opera",
+	5: "safari",
+	6: "microsoft_edge",
+}
+
+// websiteBrowserSubTypes maps the DVLS WebBrowserApplication values to the
+// matching website entry subtype.
+var websiteBrowserSubTypes map[int]dvls.ServerConnectionSubType = map[int]dvls.ServerConnectionSubType{
+	0: dvls.ServerConnectionSubTypeDefault,
+	1: dvls.ServerConnectionSubTypeInternetExplorer,
+	2: dvls.ServerConnectionSubTypeFirefox,
+	3: dvls.ServerConnectionSubTypeGoogleChrome,
+	4: dvls.ServerConnectionSubTypeOpera,
+	5: dvls.ServerConnectionSubTypeAppleSafari,
+	6: dvls.ServerConnectionSubTypeMicrosoftEdge,
+}
+
+func newEntryWebsiteFromResourceModel(rm *EntryWebsiteResourceModel) (dvls.EntryWebsite, error) {
+	var tags []string
+
+	for _, v := range rm.Tags {
+		tags = append(tags, v.ValueString())
+	}
+
+	browser, err := lookupMapValue(websiteBrowserApplications, rm.WebBrowserApplication.ValueString())
+	if err != nil {
+		return dvls.EntryWebsite{}, err
+	}
+
+	var password *string
+	if !rm.Password.IsNull() {
+		password = rm.Password.ValueStringPointer()
+	}
+
+	entryWebsite := dvls.EntryWebsite{
+		Id:                rm.Id.ValueString(),
+		VaultId:           rm.VaultId.ValueString(),
+		EntryName:         rm.Name.ValueString(),
+		EntryFolderPath:   rm.Folder.ValueString(),
+		Description:       rm.Description.ValueString(),
+		ConnectionType:    dvls.ServerConnectionWebBrowser,
+		ConnectionSubType: websiteBrowserSubTypes[browser],
+		Tags:              tags,
+		WebsiteDetails: dvls.EntryWebsiteAuthDetails{
+			URL:                   rm.Url.ValueString(),
+			WebBrowserApplication: browser,
+			Username:              rm.Username.ValueString(),
+			Password:              password,
+		},
+	}
+
+	return entryWebsite, nil
+}
+
+func setEntryWebsiteResourceModel(entryWebsite dvls.EntryWebsite, rm *EntryWebsiteResourceModel) {
+	var model EntryWebsiteResourceModel
+
+	model.Id = basetypes.NewStringValue(entryWebsite.Id)
+	model.VaultId = basetypes.NewStringValue(entryWebsite.VaultId)
+	model.Name = basetypes.NewStringValue(entryWebsite.EntryName)
+	model.WebBrowserApplication = basetypes.NewStringValue(websiteBrowserApplications[entryWebsite.WebsiteDetails.WebBrowserApplication])
+
+	if entryWebsite.EntryFolderPath != "" {
+		model.Folder = basetypes.NewStringValue(entryWebsite.EntryFolderPath)
+	}
+
+	if entryWebsite.Description != "" {
+		model.Description = basetypes.NewStringValue(entryWebsite.Description)
+	}
+
+	if entryWebsite.Tags != nil {
+		var tagsBase []types.String
+
+		for _, v := range entryWebsite.Tags {
+			tagsBase = append(tagsBase, basetypes.NewStringValue(v))
+		}
+
+		model.Tags = tagsBase
+	}
+
+	if entryWebsite.WebsiteDetails.URL != "" {
+		model.Url = basetypes.NewStringValue(entryWebsite.WebsiteDetails.URL)
+	}
+
+	if entryWebsite.WebsiteDetails.Username != "" {
+		model.Username = basetypes.NewStringValue(entryWebsite.WebsiteDetails.Username)
+	}
+
+	if entryWebsite.WebsiteDetails.Password != nil && *entryWebsite.WebsiteDetails.Password != "" {
+		model.Password = basetypes.NewStringValue(*entryWebsite.WebsiteDetails.Password)
+	}
+
+	*rm = model
+}
+
+// fetchEntryWebsite returns the website entry specified by id, including its password.
+func fetchEntryWebsite(ctx context.Context, client *dvlsClient, id string) (dvls.EntryWebsite, error) {
+	entryWebsite, err := client.Entries.Website.GetWithContext(ctx, id)
+	if err != nil {
+		return dvls.EntryWebsite{}, err
+	}
+
+	return client.Entries.Website.GetWebsiteDetailsWithContext(ctx, entryWebsite)
+}
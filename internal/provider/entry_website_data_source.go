@@ -2,17 +2,22 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &EntryWebsiteDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &EntryWebsiteDataSource{}
 
 func NewEntryWebsiteDataSource() datasource.DataSource {
 	return &EntryWebsiteDataSource{}
@@ -20,7 +25,7 @@ func NewEntryWebsiteDataSource() datasource.DataSource {
 
 // EntryWebsiteDataSource defines the resource implementation.
 type EntryWebsiteDataSource struct {
-	client *dvls.Client
+	client *dvlsClient
 }
 
 // EntryWebsiteDataSourceModel describes the resource data model.
@@ -34,7 +39,7 @@ type EntryWebsiteDataSourceModel struct {
 
 	// General
 	Url                   types.String `tfsdk:"url"`
-	WebBrowserApplication types.Int64  `tfsdk:"web_browser_application"`
+	WebBrowserApplication types.String `tfsdk:"web_browser_application"`
 	Username              types.String `tfsdk:"username"`
 	Password              types.String `tfsdk:"password"`
 }
@@ -49,21 +54,28 @@ func (d *EntryWebsiteDataSource) Schema(ctx context.Context, req datasource.Sche
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "User Credential ID",
-				Required:    true,
+				Description: "Website ID. Either id or name must be specified.",
+				Optional:    true,
+				Computed:    true,
 				Validators:  []validator.String{entryIdValidator{}},
 			},
 			"vault_id": schema.StringAttribute{
-				Description: "Vault ID",
+				Description: "Vault ID. Required when looking up the website by name.",
+				Optional:    true,
 				Computed:    true,
+				Validators:  []validator.String{vaultIdValidator{}},
 			},
 			"name": schema.StringAttribute{
-				Description: "Website name",
+				Description: "Website name. Either id or name must be specified.",
+				Optional:    true,
 				Computed:    true,
+				Validators:  []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("vault_id"))},
 			},
 			"folder": schema.StringAttribute{
-				Description: "Website folder path",
+				Description: "Website folder path. When looking up the website by name, returns entries in the specified folder and all sub-folders.",
+				Optional:    true,
 				Computed:    true,
+				Validators:  []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("name"))},
 			},
 			"description": schema.StringAttribute{
 				Description: "Website description",
@@ -78,8 +90,8 @@ func (d *EntryWebsiteDataSource) Schema(ctx context.Context, req datasource.Sche
 				Description: "Website URL",
 				Computed:    true,
 			},
-			"web_browser_application": schema.Int64Attribute{
-				Description: "Web browser application ID",
+			"web_browser_application": schema.StringAttribute{
+				Description: "Web browser application",
 				Computed:    true,
 			},
 			"username": schema.StringAttribute{
@@ -95,18 +107,27 @@ func (d *EntryWebsiteDataSource) Schema(ctx context.Context, req datasource.Sche
 	}
 }
 
+func (d *EntryWebsiteDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *EntryWebsiteDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*dvls.Client)
+	client, ok := req.ProviderData.(*dvlsClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *dvls.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.dvlsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -116,6 +137,11 @@ func (d *EntryWebsiteDataSource) Configure(ctx context.Context, req datasource.C
 }
 
 func (d *EntryWebsiteDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
 	var data EntryWebsiteDataSourceModel
 
 	diags := req.Config.Get(ctx, &data)
@@ -124,21 +150,28 @@ func (d *EntryWebsiteDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	entryWebsite, err := d.client.Entries.Website.Get(data.Id.ValueString())
+	entryWebsiteId, err := fetchLegacyEntryId(ctx, d.client, data.VaultId, data.Id, data.Name, data.Folder, dvls.ServerConnectionWebBrowser, "")
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Website Entry",
-			err.Error(),
-		)
+		if errors.Is(err, dvls.ErrMultipleEntriesFound) {
+			resp.Diagnostics.AddError(
+				"multiple entries found",
+				fmt.Sprintf("more than one website entry named %q found, use id to target the correct one", data.Name.ValueString()),
+			)
+			return
+		}
+		addDvlsError(&resp.Diagnostics, "unable to read website entry", err)
 		return
 	}
 
-	entryWebsiteSensitiveData, err := d.client.Entries.Website.GetWebsiteDetails(entryWebsite)
+	entryWebsite, err := d.client.Entries.Website.GetWithContext(ctx, entryWebsiteId)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Website Entry Sensitive Data",
-			err.Error(),
-		)
+		addDvlsError(&resp.Diagnostics, "unable to read website entry", err)
+		return
+	}
+
+	entryWebsiteSensitiveData, err := d.client.Entries.Website.GetWebsiteDetailsWithContext(ctx, entryWebsite)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to read website entry sensitive data", err)
 		return
 	}
 
@@ -154,7 +187,7 @@ func (d *EntryWebsiteDataSource) Read(ctx context.Context, req datasource.ReadRe
 	data.Tags = tags
 
 	data.Url = types.StringValue(entryWebsiteSensitiveData.WebsiteDetails.URL)
-	data.WebBrowserApplication = types.Int64Value(int64(entryWebsiteSensitiveData.WebsiteDetails.WebBrowserApplication))
+	data.WebBrowserApplication = types.StringValue(websiteBrowserApplications[entryWebsiteSensitiveData.WebsiteDetails.WebBrowserApplication])
 	data.Username = types.StringValue(entryWebsiteSensitiveData.WebsiteDetails.Username)
 	data.Password = types.StringValue(*entryWebsiteSensitiveData.WebsiteDetails.Password)
 
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestEntryWebsiteDataSource_notFound(t *testing.T) {
+	const entryId = "00000000-0000-0000-0000-000000000002"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case isLoggedEndpoint:
+			w.Write([]byte("true"))
+		case entryPartialEndpoint + "/" + entryId:
+			w.Write([]byte(`{"result":6}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Cleanup(func() { http.DefaultTransport = defaultTransport })
+	http.DefaultTransport = newTokenLoginTransport(defaultTransport, "token")
+
+	client, err := dvls.NewClient("", "", server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	d := &EntryWebsiteDataSource{client: &dvlsClient{Client: &client, baseUri: server.URL}}
+
+	ctx := context.Background()
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	attributes["vault_id"] = tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001")
+	attributes["id"] = tftypes.NewValue(tftypes.String, entryId)
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, attributes),
+	}}, &resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %v", resp.Diagnostics)
+	}
+
+	diagnostic := resp.Diagnostics.Errors()[0]
+	if diagnostic.Summary() != "unable to read website entry" {
+		t.Errorf("unexpected summary %q", diagnostic.Summary())
+	}
+
+	if !strings.Contains(diagnostic.Detail(), dvlsErrorHints[dvlsErrorNotFound]) {
+		t.Errorf("expected the not found hint in the detail, got %q", diagnostic.Detail())
+	}
+}
+
+func TestAccEntryWebsiteDataSource_byName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEntryWebsiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntryWebsiteDataSourceConfig_byName("tf_test_website_by_name", "tf_test_website_by_name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "id", "dvls_entry_website.test", "id"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "vault_id", "dvls_entry_website.test", "vault_id"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "name", "dvls_entry_website.test", "name"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "description", "dvls_entry_website.test", "description"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "folder", "dvls_entry_website.test", "folder"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "tags.#", "dvls_entry_website.test", "tags.#"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "url", "dvls_entry_website.test", "url"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "web_browser_application", "dvls_entry_website.test", "web_browser_application"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "username", "dvls_entry_website.test", "username"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "password", "dvls_entry_website.test", "password"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEntryWebsiteDataSource_byId(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEntryWebsiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntryWebsiteDataSourceConfig_byId("tf_test_website_by_id", "tf_test_website_by_id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "id", "dvls_entry_website.test", "id"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "vault_id", "dvls_entry_website.test", "vault_id"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "name", "dvls_entry_website.test", "name"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "description", "dvls_entry_website.test", "description"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "folder", "dvls_entry_website.test", "folder"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "tags.#", "dvls_entry_website.test", "tags.#"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "url", "dvls_entry_website.test", "url"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "web_browser_application", "dvls_entry_website.test", "web_browser_application"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "username", "dvls_entry_website.test", "username"),
+					resource.TestCheckResourceAttrPair("data.dvls_entry_website.test", "password", "dvls_entry_website.test", "password"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEntryWebsiteDataSourceConfig_byName(vaultName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "dvls_vault" "test" {
+  name = %[2]q
+}
+
+resource "dvls_entry_website" "test" {
+  vault_id    = dvls_vault.test.id
+  name        = %[3]q
+  description = "test entry for data source"
+  folder      = "tf_test_folder"
+  tags        = ["tf-test", "acceptance"]
+  url         = "https://app01.example.com"
+  username    = "testuser"
+  password    = "testpassword123"
+}
+
+data "dvls_entry_website" "test" {
+  vault_id = dvls_vault.test.id
+  name     = dvls_entry_website.test.name
+  folder   = "tf_test_folder"
+}
+`, testAccProviderConfig(), vaultName, name)
+}
+
+func testAccEntryWebsiteDataSourceConfig_byId(vaultName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "dvls_vault" "test" {
+  name = %[2]q
+}
+
+resource "dvls_entry_website" "test" {
+  vault_id    = dvls_vault.test.id
+  name        = %[3]q
+  description = "test entry for data source"
+  folder      = "tf_test_folder"
+  tags        = ["tf-test", "acceptance"]
+  url         = "https://app01.example.com"
+  username    = "testuser"
+  password    = "testpassword123"
+}
+
+data "dvls_entry_website" "test" {
+  id = dvls_entry_website.test.id
+}
+`, testAccProviderConfig(), vaultName, name)
+}
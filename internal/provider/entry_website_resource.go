@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EntryWebsiteResource{}
+var _ resource.ResourceWithImportState = &EntryWebsiteResource{}
+
+func NewEntryWebsiteResource() resource.Resource {
+	return &EntryWebsiteResource{}
+}
+
+// EntryWebsiteResource defines the resource implementation.
+type EntryWebsiteResource struct {
+	client *dvlsClient
+}
+
+// EntryWebsiteResourceModel describes the resource data model.
+type EntryWebsiteResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	VaultId     types.String   `tfsdk:"vault_id"`
+	Name        types.String   `tfsdk:"name"`
+	Folder      types.String   `tfsdk:"folder"`
+	Description types.String   `tfsdk:"description"`
+	Tags        []types.String `tfsdk:"tags"`
+
+	// General
+	Url                   types.String `tfsdk:"url"`
+	WebBrowserApplication types.String `tfsdk:"web_browser_application"`
+	Username              types.String `tfsdk:"username"`
+	Password              types.String `tfsdk:"password"`
+}
+
+func (r *EntryWebsiteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entry_website"
+}
+
+func (r *EntryWebsiteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A DVLS Website Entry",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "The ID of the entry. This is set by the provider after creation.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"vault_id": schema.StringAttribute{
+				Description:   "The ID of the vault.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the entry.",
+				Required:    true,
+			},
+			"folder": schema.StringAttribute{
+				Description: "The folder path where the entry is created.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the entry.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "A list of tags to add to the entry.",
+				Optional:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "The website URL.",
+				Required:    true,
+			},
+			"web_browser_application": schema.StringAttribute{
+				Description: fmt.Sprintf("The web browser used to open the website. Must be one of the following: %s. Defaults to `default`.", listMapValues(websiteBrowserApplications)),
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(websiteBrowserApplications[0]),
+				Validators:  []validator.String{stringvalidator.OneOf(slices.Collect(maps.Values(websiteBrowserApplications))...)},
+			},
+			"username": schema.StringAttribute{
+				Description: "The website username.",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The website password.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *EntryWebsiteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dvlsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.dvlsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EntryWebsiteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	var plan *EntryWebsiteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entryWebsite, err := newEntryWebsiteFromResourceModel(plan)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to create website entry", err)
+		return
+	}
+
+	entryWebsiteId, err := r.client.saveEntry(ctx, http.MethodPost, entryWebsite)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to create website entry", err)
+		return
+	}
+
+	entryWebsite, err = fetchEntryWebsite(ctx, r.client, entryWebsiteId)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to fetch created website entry", err)
+		return
+	}
+
+	setEntryWebsiteResourceModel(entryWebsite, plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *EntryWebsiteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Keep the current state until the provider configuration is known.
+	if !r.client.configured() {
+		return
+	}
+
+	var state *EntryWebsiteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entryWebsite, err := fetchEntryWebsite(ctx, r.client, state.Id.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addDvlsError(&resp.Diagnostics, "unable to read website entry", err)
+		return
+	}
+
+	setEntryWebsiteResourceModel(entryWebsite, state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *EntryWebsiteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	var plan *EntryWebsiteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entryWebsite, err := newEntryWebsiteFromResourceModel(plan)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to update website entry", err)
+		return
+	}
+
+	_, err = r.client.saveEntry(ctx, http.MethodPut, entryWebsite)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to update website entry", err)
+		return
+	}
+
+	entryWebsite, err = fetchEntryWebsite(ctx, r.client, entryWebsite.Id)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to fetch updated website entry", err)
+		return
+	}
+
+	setEntryWebsiteResourceModel(entryWebsite, plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *EntryWebsiteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	var state *EntryWebsiteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.deleteEntry(ctx, state.Id.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addDvlsError(&resp.Diagnostics, "unable to delete website entry", err)
+		return
+	}
+}
+
+func (r *EntryWebsiteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	vaultId, entryId, err := parseEntryImportId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Resource ID", err.Error())
+		return
+	}
+
+	entryWebsite, err := r.client.Entries.Website.GetWithContext(ctx, entryId)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to read entry", err)
+		return
+	}
+
+	if entryWebsite.ConnectionType != dvls.ServerConnectionWebBrowser {
+		resp.Diagnostics.AddError("invalid entry type", "expected a website entry.")
+		return
+	}
+
+	if entryWebsite.VaultId != vaultId {
+		resp.Diagnostics.AddError("invalid vault id", fmt.Sprintf("entry %s does not belong to vault %s.", entryId, vaultId))
+		return
+	}
+
+	resp.State.SetAttribute(ctx, path.Root("vault_id"), vaultId)
+	resp.State.SetAttribute(ctx, path.Root("id"), entryId)
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccEntryWebsiteResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEntryWebsiteDestroy,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: testAccEntryWebsiteResourceConfig(
+					"tf_test_website", "tf_test_website", "test description", "tf_test_folder",
+					"https://app01.example.com", "google_chrome", "testuser", "testpassword123",
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dvls_entry_website.test", "id"),
+					resource.TestCheckResourceAttrPair("dvls_entry_website.test", "vault_id", "dvls_vault.test", "id"),
+					resource.TestCheckResourceAttr("dvls_entry_website.test", "name", "tf_test_website"),
+					resource.TestCheckResourceAttr("dvls_entry_website.test", "description", "test description"),
+					resource.TestCheckResourceAttr("dvls_entry_website.test", "folder", "tf_test_folder"),
+					resource.TestCheckResourceAttr("dvls_entry_website.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("dvls_entry_website.test", "tags.0", "tf-test"),
+					resource.TestCheckResourceAttr("dvls_entry_website.test", "tags.1", "acceptance"),
+					resource.TestCheckResourceAttr("dvls_entry_website.test", "url", "https://app01.example.com"),
+					resource.TestCheckResourceAttr("dvls_entry_website.test", "web_browser_application", "google_chrome"),
+					resource.TestCheckResourceAttr("dvls_entry_website.test", "username", "testuser"),
+					resource.TestCheckResourceAttr("dvls_entry_website.test", "password", "testpassword123"),
+				),
+			},
+			// Update
+			{
+				Config: testAccEntryWebsiteResourceConfig(
+					"tf_test_website", "tf_test_website_updated", "updated description", "tf_test_folder_updated",
+					"https://app02.example.com", "firefox", "updateduser", "updatedpassword456",
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dvls_entry_website.test", "name", "tf_test_website_updated"),
+					resource.TestCheckResourceAttr("dvls_entry_website.test", "description", "updated description"),
+					resource.TestCheckResourceAttr("dvls_entry_website.test", "folder", "tf_test_folder_updated"),
+					resource.TestCheckResourceAttr("dvls_entry_website.test", "url", "https://app02.example.com"),
+					resource.TestCheckResourceAttr("dvls_entry_website.test", "web_browser_application", "firefox"),
+					resource.TestCheckResourceAttr("dvls_entry_website.test", "username", "updateduser"),
+					resource.TestCheckResourceAttr("dvls_entry_website.test", "password", "updatedpassword456"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "dvls_entry_website.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccEntryCredentialImportStateIdFunc("dvls_entry_website.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEntryWebsiteDestroy(s *terraform.State) error {
+	client, err := getTestAccClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dvls_entry_website" {
+			continue
+		}
+
+		_, err := client.Entries.Website.Get(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("entry %s still exists", rs.Primary.ID)
+		}
+
+		if !isNotFound(err) {
+			return fmt.Errorf("unexpected error checking entry %s: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccEntryWebsiteResourceConfig(vaultName, name, description, folder, url, webBrowserApplication, username, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "dvls_vault" "test" {
+  name = %[2]q
+}
+
+resource "dvls_entry_website" "test" {
+  vault_id                = dvls_vault.test.id
+  name                    = %[3]q
+  description             = %[4]q
+  folder                  = %[5]q
+  tags                    = ["tf-test", "acceptance"]
+  url                     = %[6]q
+  web_browser_application = %[7]q
+  username                = %[8]q
+  password                = %[9]q
+}
+`, testAccProviderConfig(), vaultName, name, description, folder, url, webBrowserApplication, username, password)
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// dvlsErrorKind classifies the errors returned by DVLS.
+type dvlsErrorKind int
+
+const (
+	dvlsErrorUnknown dvlsErrorKind = iota
+	dvlsErrorNotFound
+	dvlsErrorUnauthorized
+	dvlsErrorForbidden
+	dvlsErrorConflict
+	dvlsErrorValidation
+)
+
+// dvlsErrorHints are added to the diagnostics to tell how to solve each kind
+// of error.
+var dvlsErrorHints = map[dvlsErrorKind]string{
+	dvlsErrorNotFound:     "The object does not exist in DVLS, or the application identity of the provider cannot access it. Check the ID, the vault ID and the vault permissions.",
+	dvlsErrorUnauthorized: "DVLS rejected the credentials of the provider. Check the application identity (app_id and app_secret) or the session token, and that it is still enabled.",
+	dvlsErrorForbidden:    "The application identity of the provider is not allowed to do this operation. Grant it the required permissions on the vault in DVLS.",
+	dvlsErrorConflict:     "The object already exists or was modified in DVLS at the same time. Refresh the state, then try again.",
+	dvlsErrorValidation:   "DVLS rejected the values sent by the provider. Check the values of the attributes.",
+}
+
+// classifyError returns the kind of an error returned by go-dvls or by the
+// requests the provider sends to DVLS.
+func classifyError(err error) dvlsErrorKind {
+	if err == nil {
+		return dvlsErrorUnknown
+	}
+
+	if errors.Is(err, dvls.ErrEntryNotFound) || errors.Is(err, dvls.ErrVaultNotFound) {
+		return dvlsErrorNotFound
+	}
+
+	var reqErr *dvls.RequestError
+	if errors.As(err, &reqErr) {
+		switch reqErr.StatusCode {
+		case http.StatusNotFound:
+			return dvlsErrorNotFound
+		case http.StatusUnauthorized:
+			return dvlsErrorUnauthorized
+		case http.StatusForbidden:
+			return dvlsErrorForbidden
+		case http.StatusConflict:
+			return dvlsErrorConflict
+		case http.StatusBadRequest, http.StatusUnprocessableEntity:
+			return dvlsErrorValidation
+		}
+	}
+
+	// The legacy endpoints answer with a 200 status code and report the result
+	// of the operation in the body, which go-dvls only returns in the message.
+	message := err.Error()
+
+	switch {
+	case strings.Contains(message, dvls.SaveResultNotFound.String()):
+		return dvlsErrorNotFound
+	case strings.Contains(message, dvls.SaveResultAccessDenied.String()):
+		return dvlsErrorForbidden
+	case strings.Contains(message, dvls.SaveResultAlreadyExists.String()):
+		return dvlsErrorConflict
+	case strings.Contains(message, dvls.SaveResultInvalidData.String()):
+		return dvlsErrorValidation
+	}
+
+	return dvlsErrorUnknown
+}
+
+// isNotFound reports whether err means that the object does not exist in DVLS.
+func isNotFound(err error) bool {
+	return classifyError(err) == dvlsErrorNotFound
+}
+
+// addDvlsError adds an error diagnostic for err, with a hint telling how to
+// solve it when its kind is known.
+func addDvlsError(diags *diag.Diagnostics, summary string, err error) {
+	detail := err.Error()
+
+	if hint, ok := dvlsErrorHints[classifyError(err)]; ok {
+		detail += "\n\n" + hint
+	}
+
+	diags.AddError(summary, detail)
+}
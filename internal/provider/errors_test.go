@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want dvlsErrorKind
+	}{
+		{"nil", nil, dvlsErrorUnknown},
+		{"entry not found", fmt.Errorf("error while fetching entry: %w", dvls.ErrEntryNotFound), dvlsErrorNotFound},
+		{"vault not found", dvls.ErrVaultNotFound, dvlsErrorNotFound},
+		{"status 404", &dvls.RequestError{StatusCode: http.StatusNotFound, Err: errors.New("unexpected status code")}, dvlsErrorNotFound},
+		{"status 401", &dvls.RequestError{StatusCode: http.StatusUnauthorized, Err: errors.New("unexpected status code")}, dvlsErrorUnauthorized},
+		{"status 403", fmt.Errorf("error while creating entry: %w", &dvls.RequestError{StatusCode: http.StatusForbidden, Err: errors.New("unexpected status code")}), dvlsErrorForbidden},
+		{"status 409", &dvls.RequestError{StatusCode: http.StatusConflict, Err: errors.New("unexpected status code")}, dvlsErrorConflict},
+		{"status 400", &dvls.RequestError{StatusCode: http.StatusBadRequest, Err: errors.New("unexpected status code")}, dvlsErrorValidation},
+		{"status 422", &dvls.RequestError{StatusCode: http.StatusUnprocessableEntity, Err: errors.New("unexpected status code")}, dvlsErrorValidation},
+		{"status 500", &dvls.RequestError{StatusCode: http.StatusInternalServerError, Err: errors.New("unexpected status code")}, dvlsErrorUnknown},
+		{"save result not found", dvls.Response{Result: uint8(dvls.SaveResultNotFound)}.CheckRespSaveResult(), dvlsErrorNotFound},
+		{"save result access denied", dvls.Response{Result: uint8(dvls.SaveResultAccessDenied)}.CheckRespSaveResult(), dvlsErrorForbidden},
+		{"save result already exists", dvls.Response{Result: uint8(dvls.SaveResultAlreadyExists)}.CheckRespSaveResult(), dvlsErrorConflict},
+		{"save result invalid data", dvls.Response{Result: uint8(dvls.SaveResultInvalidData)}.CheckRespSaveResult(), dvlsErrorValidation},
+		{"other error", errors.New("connection refused"), dvlsErrorUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyError(tt.err); got != tt.want {
+				t.Errorf("classifyError(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddDvlsError(t *testing.T) {
+	var diags diag.Diagnostics
+	addDvlsError(&diags, "unable to read vault", &dvls.RequestError{StatusCode: http.StatusForbidden, Err: errors.New("access denied")})
+
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %d", diags.ErrorsCount())
+	}
+
+	d := diags.Errors()[0]
+	if d.Summary() != "unable to read vault" {
+		t.Errorf("unexpected summary %q", d.Summary())
+	}
+
+	if !strings.Contains(d.Detail(), dvlsErrorHints[dvlsErrorForbidden]) {
+		t.Errorf("expected the forbidden hint in the detail, got %q", d.Detail())
+	}
+
+	diags = nil
+	addDvlsError(&diags, "unable to read vault", errors.New("connection refused"))
+
+	if d := diags.Errors()[0]; d.Detail() != "connection refused" {
+		t.Errorf("expected no hint for an unknown error, got %q", d.Detail())
+	}
+}
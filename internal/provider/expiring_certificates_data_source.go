@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ExpiringCertificatesDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ExpiringCertificatesDataSource{}
+
+func NewExpiringCertificatesDataSource() datasource.DataSource {
+	return &ExpiringCertificatesDataSource{}
+}
+
+// ExpiringCertificatesDataSource defines the data source implementation.
+type ExpiringCertificatesDataSource struct {
+	client *dvlsClient
+}
+
+// ExpiringCertificatesDataSourceModel describes the data source data model.
+type ExpiringCertificatesDataSourceModel struct {
+	VaultIds      []types.String    `tfsdk:"vault_ids"`
+	ExpiresBefore timetypes.RFC3339 `tfsdk:"expires_before"`
+	WithinDays    types.Int64       `tfsdk:"within_days"`
+
+	Certificates []ExpiringCertificatesDataSourceCertificateModel `tfsdk:"certificates"`
+}
+
+// ExpiringCertificatesDataSourceCertificateModel describes a certificate entry
+// returned by the data source.
+type ExpiringCertificatesDataSourceCertificateModel struct {
+	Id         types.String      `tfsdk:"id"`
+	VaultId    types.String      `tfsdk:"vault_id"`
+	Name       types.String      `tfsdk:"name"`
+	Folder     types.String      `tfsdk:"folder"`
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+}
+
+func (d *ExpiringCertificatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_expiring_certificates"
+}
+
+func (d *ExpiringCertificatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the certificate entries of DVLS vaults that expire before a given time, already expired ones included. Certificate files and passwords are never returned.",
+
+		Attributes: map[string]schema.Attribute{
+			"vault_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "The IDs of the vaults to scan.",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(vaultIdValidator{}),
+				},
+			},
+			"expires_before": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Description: "Only return the certificates that expire before this time, in RFC3339 format (e.g. 2022-12-31T23:59:59-05:00). Either expires_before or within_days must be specified.",
+				Optional:    true,
+			},
+			"within_days": schema.Int64Attribute{
+				Description: "Only return the certificates that expire within this number of days. Either expires_before or within_days must be specified.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"certificates": schema.ListNestedAttribute{
+				Description: "The certificate entries expiring before the given time, sorted by expiration.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the entry.",
+							Computed:    true,
+						},
+						"vault_id": schema.StringAttribute{
+							Description: "The ID of the vault of the entry.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the entry.",
+							Computed:    true,
+						},
+						"folder": schema.StringAttribute{
+							Description: "The folder path of the entry.",
+							Computed:    true,
+						},
+						"expiration": schema.StringAttribute{
+							CustomType:  timetypes.RFC3339Type{},
+							Description: "The expiration date of the certificate, in RFC3339 format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ExpiringCertificatesDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("expires_before"),
+			path.MatchRoot("within_days"),
+		),
+	}
+}
+
+func (d *ExpiringCertificatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dvlsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.dvlsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ExpiringCertificatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	var data *ExpiringCertificatesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	before := time.Now().AddDate(0, 0, int(data.WithinDays.ValueInt64()))
+	if !data.ExpiresBefore.IsNull() {
+		expiresBefore, diags := data.ExpiresBefore.ValueRFC3339Time()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		before = expiresBefore
+	}
+
+	data.Certificates = []ExpiringCertificatesDataSourceCertificateModel{}
+
+	for _, vaultId := range data.VaultIds {
+		certificates, err := d.client.listExpiringCertificates(ctx, vaultId.ValueString(), before)
+		if err != nil {
+			addDvlsError(&resp.Diagnostics, "unable to list expiring certificates", err)
+			return
+		}
+
+		for _, certificate := range certificates {
+			data.Certificates = append(data.Certificates, ExpiringCertificatesDataSourceCertificateModel{
+				Id:         basetypes.NewStringValue(certificate.Id),
+				VaultId:    basetypes.NewStringValue(certificate.VaultId),
+				Name:       basetypes.NewStringValue(certificate.Name),
+				Folder:     basetypes.NewStringValue(certificate.Folder),
+				Expiration: timetypes.NewRFC3339TimeValue(certificate.Expiration),
+			})
+		}
+	}
+
+	slices.SortStableFunc(data.Certificates, func(a, b ExpiringCertificatesDataSourceCertificateModel) int {
+		aTime, _ := a.Expiration.ValueRFC3339Time()
+		bTime, _ := b.Expiration.ValueRFC3339Time()
+		return aTime.Compare(bTime)
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// expiringCertificate is a certificate entry returned by
+// listExpiringCertificates.
+type expiringCertificate struct {
+	Id         string
+	VaultId    string
+	Name       string
+	Folder     string
+	Expiration time.Time
+}
+
+// listExpiringCertificates returns the certificate entries of the vault that
+// have an expiration before the given time. Credential entries are skipped
+// without fetching their type, as they cannot be certificates, and the other
+// entries are fetched once each. The entries deleted while the vault is
+// scanned are skipped.
+func (c *dvlsClient) listExpiringCertificates(ctx context.Context, vaultId string, before time.Time) ([]expiringCertificate, error) {
+	entries, err := c.listEntries(ctx, vaultId, dvls.GetEntriesOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var certificates []expiringCertificate
+	for _, entry := range entries {
+		if entry.Type == dvls.EntryCredentialType {
+			continue
+		}
+
+		// The expiration is only decoded for certificates, as its format is
+		// not known for the other entry types.
+		var legacyEntry struct {
+			legacyEntryType
+			Expiration json.RawMessage `json:"expiration"`
+		}
+
+		err := c.getLegacyEntry(ctx, entry.Id, &legacyEntry)
+		if isNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		if legacyEntry.ConnectionType != dvls.ServerConnectionDocument || legacyEntry.ConnectionSubType != dvls.ServerConnectionSubTypeCertificate {
+			continue
+		}
+
+		var expiration time.Time
+		if len(legacyEntry.Expiration) > 0 && string(legacyEntry.Expiration) != "null" {
+			if err := json.Unmarshal(legacyEntry.Expiration, &expiration); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal expiration of entry %s: %w", entry.Id, err)
+			}
+		}
+
+		// Certificates without an expiration never expire.
+		if expiration.IsZero() || !expiration.Before(before) {
+			continue
+		}
+
+		certificates = append(certificates, expiringCertificate{
+			Id:         entry.Id,
+			VaultId:    vaultId,
+			Name:       entry.Name,
+			Folder:     entry.Path,
+			Expiration: expiration,
+		})
+	}
+
+	return certificates, nil
+}
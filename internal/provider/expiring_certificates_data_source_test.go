@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Devolutions/go-dvls"
+)
+
+func TestListExpiringCertificates(t *testing.T) {
+	const vaultId = "00000000-0000-0000-0000-000000000001"
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	entries := map[string]map[string]any{
+		"soon": {
+			"connectionType":    dvls.ServerConnectionDocument,
+			"connectionSubType": dvls.ServerConnectionSubTypeCertificate,
+			"expiration":        now.AddDate(0, 0, 10),
+		},
+		"later": {
+			"connectionType":    dvls.ServerConnectionDocument,
+			"connectionSubType": dvls.ServerConnectionSubTypeCertificate,
+			"expiration":        now.AddDate(1, 0, 0),
+		},
+		"never": {
+			"connectionType":    dvls.ServerConnectionDocument,
+			"connectionSubType": dvls.ServerConnectionSubTypeCertificate,
+		},
+		"host": {
+			"connectionType": dvls.ServerConnectionHost,
+			"expiration":     now.AddDate(0, 0, -10),
+		},
+	}
+
+	fetches := map[string]int{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == isLoggedEndpoint:
+			w.Write([]byte("true"))
+		case r.URL.Path == strings.ReplaceAll(entryListEndpoint, "{vaultId}", vaultId):
+			w.Write([]byte(`{"totalPage":1,"data":[
+				{"id":"credential","name":"credential","type":"Credential"},
+				{"id":"soon","name":"soon","path":"certificates","type":"Document"},
+				{"id":"later","name":"later","type":"Document"},
+				{"id":"never","name":"never","type":"Document"},
+				{"id":"deleted","name":"deleted","type":"Document"},
+				{"id":"host","name":"host","type":"Host"}
+			]}`))
+		case strings.HasPrefix(r.URL.Path, entryPartialEndpoint+"/"):
+			id := strings.TrimPrefix(r.URL.Path, entryPartialEndpoint+"/")
+			fetches[id]++
+
+			if id == "deleted" {
+				w.Write([]byte(`{"result":6}`))
+				return
+			}
+
+			entry, ok := entries[id]
+			if !ok {
+				t.Errorf("unexpected entry %s fetched", id)
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			entry["id"] = id
+			entry["name"] = id
+			entry["repositoryId"] = vaultId
+
+			json.NewEncoder(w).Encode(map[string]any{"result": 1, "data": entry})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Cleanup(func() { http.DefaultTransport = defaultTransport })
+	http.DefaultTransport = newTokenLoginTransport(defaultTransport, "token")
+
+	client, err := dvls.NewClient("", "", server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	dvlsClient := &dvlsClient{Client: &client, baseUri: server.URL}
+
+	certificates, err := dvlsClient.listExpiringCertificates(context.Background(), vaultId, now.AddDate(0, 0, 30))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(certificates) != 1 || certificates[0].Id != "soon" {
+		t.Fatalf("expected only the certificate expiring within 30 days, got %+v", certificates)
+	}
+
+	for id, count := range fetches {
+		if count != 1 {
+			t.Errorf("expected entry %s to be fetched once, got %d", id, count)
+		}
+	}
+
+	if certificates[0].VaultId != vaultId || certificates[0].Folder != "certificates" || !certificates[0].Expiration.Equal(now.AddDate(0, 0, 10)) {
+		t.Errorf("unexpected certificate %+v", certificates[0])
+	}
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func newFolderFromResourceModel(rm *FolderResourceModel) dvls.Entry {
+	var tags []string
+
+	for _, v := range rm.Tags {
+		tags = append(tags, v.ValueString())
+	}
+
+	folder := dvls.Entry{
+		Id:          rm.Id.ValueString(),
+		VaultId:     rm.VaultId.ValueString(),
+		Name:        rm.Name.ValueString(),
+		Path:        rm.Folder.ValueString(),
+		Type:        dvls.EntryFolderType,
+		SubType:     dvls.EntryFolderSubTypeFolder,
+		Description: rm.Description.ValueString(),
+		Tags:        tags,
+		Data:        dvls.EntryFolderData{},
+	}
+
+	return folder
+}
+
+func setFolderResourceModel(entry dvls.Entry, rm *FolderResourceModel) {
+	var model FolderResourceModel
+
+	model.Id = basetypes.NewStringValue(entry.Id)
+	model.VaultId = basetypes.NewStringValue(entry.VaultId)
+	model.Name = basetypes.NewStringValue(entry.Name)
+	model.Path = basetypes.NewStringValue(folderFullPath(entry.Path, entry.Name))
+
+	if entry.Path != "" {
+		model.Folder = basetypes.NewStringValue(entry.Path)
+	}
+
+	if entry.Description != "" {
+		model.Description = basetypes.NewStringValue(entry.Description)
+	}
+
+	if entry.Tags != nil {
+		var tagsBase []types.String
+
+		for _, v := range entry.Tags {
+			tagsBase = append(tagsBase, basetypes.NewStringValue(v))
+		}
+
+		model.Tags = tagsBase
+	}
+
+	*rm = model
+}
+
+func setFolderDataModel(entry dvls.Entry, dsm *FolderDataSourceModel) {
+	var model FolderDataSourceModel
+
+	model.Id = basetypes.NewStringValue(entry.Id)
+	model.VaultId = basetypes.NewStringValue(entry.VaultId)
+	model.Name = basetypes.NewStringValue(entry.Name)
+	model.Path = basetypes.NewStringValue(folderFullPath(entry.Path, entry.Name))
+
+	if entry.Path != "" {
+		model.Folder = basetypes.NewStringValue(entry.Path)
+	}
+
+	if entry.Description != "" {
+		model.Description = basetypes.NewStringValue(entry.Description)
+	}
+
+	if entry.Tags != nil {
+		var tagsBase []types.String
+
+		for _, v := range entry.Tags {
+			tagsBase = append(tagsBase, basetypes.NewStringValue(v))
+		}
+
+		model.Tags = tagsBase
+	}
+
+	*dsm = model
+}
+
+// folderFullPath returns the path of the folder named name in the parent
+// folder, which is the value to use in the folder attribute of its entries.
+func folderFullPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+
+	return parent + "\\" + name
+}
+
+func fetchFolder(ctx context.Context, client *dvlsClient, vaultId, id, name, folder types.String) (dvls.Entry, error) {
+	if !id.IsNull() && !id.IsUnknown() {
+		entry, err := client.Entries.Folder.GetByIdWithContext(ctx, vaultId.ValueString(), id.ValueString())
+		if err != nil {
+			return entry, err
+		}
+		if entry.Type != dvls.EntryFolderType {
+			return entry, fmt.Errorf("expected entry type %q, got type %q", dvls.EntryFolderType, entry.Type)
+		}
+		return entry, nil
+	}
+
+	var folderPath *string
+	if !folder.IsNull() && !folder.IsUnknown() {
+		v := folder.ValueString()
+		folderPath = &v
+	}
+
+	entries, err := client.Entries.Folder.GetEntriesWithContext(ctx, vaultId.ValueString(), dvls.GetEntriesOptions{Name: name.ValueStringPointer(), Path: folderPath})
+	if err != nil {
+		return dvls.Entry{}, err
+	}
+
+	var matches []dvls.Entry
+	for _, e := range entries {
+		if e.Name == name.ValueString() {
+			matches = append(matches, e)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return dvls.Entry{}, dvls.ErrEntryNotFound
+	case 1:
+		return client.Entries.Folder.GetByIdWithContext(ctx, vaultId.ValueString(), matches[0].Id)
+	default:
+		return dvls.Entry{}, dvls.ErrMultipleEntriesFound
+	}
+}
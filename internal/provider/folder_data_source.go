@@ -16,42 +16,40 @@ import (
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ datasource.DataSource = &EntryCredentialSecretDataSource{}
-var _ datasource.DataSourceWithConfigValidators = &EntryCredentialSecretDataSource{}
+var _ datasource.DataSource = &FolderDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &FolderDataSource{}
 
-func NewEntryCredentialSecretDataSource() datasource.DataSource {
-	return &EntryCredentialSecretDataSource{}
+func NewFolderDataSource() datasource.DataSource {
+	return &FolderDataSource{}
 }
 
-// EntryCredentialSecretDataSource defines the data source implementation.
-type EntryCredentialSecretDataSource struct {
-	client *dvls.Client
+// FolderDataSource defines the data source implementation.
+type FolderDataSource struct {
+	client *dvlsClient
 }
 
-// EntryCredentialSecretDataSourceModel describes the data source data model.
-type EntryCredentialSecretDataSourceModel struct {
+// FolderDataSourceModel describes the data source data model.
+type FolderDataSourceModel struct {
 	Id          types.String   `tfsdk:"id"`
 	VaultId     types.String   `tfsdk:"vault_id"`
 	Name        types.String   `tfsdk:"name"`
 	Folder      types.String   `tfsdk:"folder"`
+	Path        types.String   `tfsdk:"path"`
 	Description types.String   `tfsdk:"description"`
 	Tags        []types.String `tfsdk:"tags"`
-
-	// General
-	Secret types.String `tfsdk:"secret"`
 }
 
-func (d *EntryCredentialSecretDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_entry_credential_secret"
+func (d *FolderDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
 }
 
-func (d *EntryCredentialSecretDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+func (d *FolderDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "A DVLS Secret Credential Entry",
+		Description: "A DVLS Folder",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "The ID of the entry.",
+				Description: "The ID of the folder.",
 				Optional:    true,
 				Computed:    true,
 				Validators:  []validator.String{entryIdValidator{}},
@@ -62,35 +60,34 @@ func (d *EntryCredentialSecretDataSource) Schema(ctx context.Context, req dataso
 				Validators:  []validator.String{vaultIdValidator{}},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the entry.",
+				Description: "The name of the folder.",
 				Optional:    true,
 				Computed:    true,
 			},
 			"folder": schema.StringAttribute{
-				Description: "The folder path to search in. Returns entries in the specified folder and all sub-folders.",
+				Description: "The parent folder path to search in. Returns folders in the specified folder and all sub-folders.",
 				Optional:    true,
 				Computed:    true,
 				Validators:  []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("name"))},
 			},
+			"path": schema.StringAttribute{
+				Description: "The full path of the folder, to use as the `folder` of the entries it contains.",
+				Computed:    true,
+			},
 			"description": schema.StringAttribute{
-				Description: "The description of the entry.",
+				Description: "The description of the folder.",
 				Computed:    true,
 			},
 			"tags": schema.ListAttribute{
 				ElementType: types.StringType,
-				Description: "A list of tags added to the entry.",
-				Computed:    true,
-			},
-			"secret": schema.StringAttribute{
-				Description: "The entry credential secret.",
+				Description: "A list of tags added to the folder.",
 				Computed:    true,
-				Sensitive:   true,
 			},
 		},
 	}
 }
 
-func (d *EntryCredentialSecretDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+func (d *FolderDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
 	return []datasource.ConfigValidator{
 		datasourcevalidator.ExactlyOneOf(
 			path.MatchRoot("id"),
@@ -99,18 +96,18 @@ func (d *EntryCredentialSecretDataSource) ConfigValidators(_ context.Context) []
 	}
 }
 
-func (d *EntryCredentialSecretDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+func (d *FolderDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*dvls.Client)
+	client, ok := req.ProviderData.(*dvlsClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *dvls.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.dvlsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -119,28 +116,33 @@ func (d *EntryCredentialSecretDataSource) Configure(ctx context.Context, req dat
 	d.client = client
 }
 
-func (d *EntryCredentialSecretDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data *EntryCredentialSecretDataSourceModel
+func (d *FolderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	var data *FolderDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	entry, err := fetchCredentialEntry(d.client, data.VaultId, data.Id, data.Name, data.Folder, dvls.EntryCredentialSubTypeAccessCode)
+	folder, err := fetchFolder(ctx, d.client, data.VaultId, data.Id, data.Name, data.Folder)
 	if err != nil {
 		if errors.Is(err, dvls.ErrMultipleEntriesFound) {
 			resp.Diagnostics.AddError(
-				"multiple entries found",
-				fmt.Sprintf("more than one entry named %q found, use id to target the correct one", data.Name.ValueString()),
+				"multiple folders found",
+				fmt.Sprintf("more than one folder named %q found, use id or folder to target the correct one", data.Name.ValueString()),
 			)
 			return
 		}
-		resp.Diagnostics.AddError("unable to read secret credential entry", err.Error())
+		addDvlsError(&resp.Diagnostics, "unable to read folder", err)
 		return
 	}
 
-	setEntryCredentialSecretDataModel(entry, data)
+	setFolderDataModel(folder, data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFolderDataSource_byName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderDataSourceConfig_byName("tf_test_folder_by_name", "tf_test_folder_by_name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.dvls_folder.test", "id", "dvls_folder.test", "id"),
+					resource.TestCheckResourceAttrPair("data.dvls_folder.test", "vault_id", "dvls_folder.test", "vault_id"),
+					resource.TestCheckResourceAttrPair("data.dvls_folder.test", "name", "dvls_folder.test", "name"),
+					resource.TestCheckResourceAttrPair("data.dvls_folder.test", "path", "dvls_folder.test", "path"),
+					resource.TestCheckResourceAttrPair("data.dvls_folder.test", "description", "dvls_folder.test", "description"),
+					resource.TestCheckResourceAttrPair("data.dvls_folder.test", "tags.#", "dvls_folder.test", "tags.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFolderDataSource_byId(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderDataSourceConfig_byId("tf_test_folder_by_id", "tf_test_folder_by_id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.dvls_folder.test", "id", "dvls_folder.test", "id"),
+					resource.TestCheckResourceAttrPair("data.dvls_folder.test", "vault_id", "dvls_folder.test", "vault_id"),
+					resource.TestCheckResourceAttrPair("data.dvls_folder.test", "name", "dvls_folder.test", "name"),
+					resource.TestCheckResourceAttrPair("data.dvls_folder.test", "path", "dvls_folder.test", "path"),
+					resource.TestCheckResourceAttrPair("data.dvls_folder.test", "description", "dvls_folder.test", "description"),
+					resource.TestCheckResourceAttrPair("data.dvls_folder.test", "tags.#", "dvls_folder.test", "tags.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFolderDataSourceConfig_byName(vaultName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "dvls_vault" "test" {
+  name = %[2]q
+}
+
+resource "dvls_folder" "test" {
+  vault_id    = dvls_vault.test.id
+  name        = %[3]q
+  description = "test folder for data source"
+  tags        = ["tf-test", "acceptance"]
+}
+
+data "dvls_folder" "test" {
+  vault_id = dvls_vault.test.id
+  name     = dvls_folder.test.name
+}
+`, testAccProviderConfig(), vaultName, name)
+}
+
+func testAccFolderDataSourceConfig_byId(vaultName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "dvls_vault" "test" {
+  name = %[2]q
+}
+
+resource "dvls_folder" "test" {
+  vault_id    = dvls_vault.test.id
+  name        = %[3]q
+  description = "test folder for data source"
+  tags        = ["tf-test", "acceptance"]
+}
+
+data "dvls_folder" "test" {
+  vault_id = dvls_vault.test.id
+  id       = dvls_folder.test.id
+}
+`, testAccProviderConfig(), vaultName, name)
+}
@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FolderResource{}
+var _ resource.ResourceWithImportState = &FolderResource{}
+var _ resource.ResourceWithModifyPlan = &FolderResource{}
+
+func NewFolderResource() resource.Resource {
+	return &FolderResource{}
+}
+
+// FolderResource defines the resource implementation.
+type FolderResource struct {
+	client *dvlsClient
+}
+
+// FolderResourceModel describes the resource data model.
+type FolderResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	VaultId     types.String   `tfsdk:"vault_id"`
+	Name        types.String   `tfsdk:"name"`
+	Folder      types.String   `tfsdk:"folder"`
+	Path        types.String   `tfsdk:"path"`
+	Description types.String   `tfsdk:"description"`
+	Tags        []types.String `tfsdk:"tags"`
+}
+
+func (r *FolderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+func (r *FolderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A DVLS Folder",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "The ID of the folder. This is set by the provider after creation.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"vault_id": schema.StringAttribute{
+				Description:   "The ID of the vault.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the folder.",
+				Required:    true,
+			},
+			"folder": schema.StringAttribute{
+				Description: "The path of the parent folder. The folder is created at the root of the vault when not set.",
+				Optional:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "The full path of the folder, to use as the `folder` of the entries it contains.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the folder.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "A list of tags to add to the folder.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *FolderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dvlsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.dvlsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan sets the planned path from the name and the parent folder, so
+// the entries that use it are only updated when the folder moves.
+func (r *FolderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *FolderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Name.IsUnknown() || plan.Folder.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("path"), folderFullPath(plan.Folder.ValueString(), plan.Name.ValueString()))...)
+}
+
+func (r *FolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	var plan *FolderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder := newFolderFromResourceModel(plan)
+
+	folderId, err := r.client.Entries.Folder.NewWithContext(ctx, folder)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to create folder", err)
+		return
+	}
+
+	folder, err = r.client.Entries.Folder.GetByIdWithContext(ctx, folder.VaultId, folderId)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to fetch created folder", err)
+		return
+	}
+
+	setFolderResourceModel(folder, plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Keep the current state until the provider configuration is known.
+	if !r.client.configured() {
+		return
+	}
+
+	var state *FolderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, err := r.client.Entries.Folder.GetByIdWithContext(ctx, state.VaultId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addDvlsError(&resp.Diagnostics, "unable to read folder", err)
+		return
+	}
+
+	setFolderResourceModel(folder, state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FolderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	var plan *FolderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder := newFolderFromResourceModel(plan)
+
+	folder, err := r.client.Entries.Folder.UpdateWithContext(ctx, folder)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to update folder", err)
+		return
+	}
+
+	setFolderResourceModel(folder, plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FolderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	var state *FolderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Entries.Folder.DeleteByIdWithContext(ctx, state.VaultId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addDvlsError(&resp.Diagnostics, "unable to delete folder", err)
+		return
+	}
+}
+
+func (r *FolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	vaultId, entryId, err := parseEntryImportId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Resource ID", err.Error())
+		return
+	}
+
+	folder, err := r.client.Entries.Folder.GetByIdWithContext(ctx, vaultId, entryId)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to read entry", err)
+		return
+	}
+
+	if folder.Type != dvls.EntryFolderType {
+		resp.Diagnostics.AddError("invalid entry type", "expected a folder entry.")
+		return
+	}
+
+	resp.State.SetAttribute(ctx, path.Root("vault_id"), vaultId)
+	resp.State.SetAttribute(ctx, path.Root("id"), entryId)
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccFolderResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckFolderDestroy,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: testAccFolderResourceConfig("tf_test_folder", "tf_test_parent", "tf_test_child", "test description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dvls_folder.parent", "id"),
+					resource.TestCheckResourceAttrPair("dvls_folder.parent", "vault_id", "dvls_vault.test", "id"),
+					resource.TestCheckResourceAttr("dvls_folder.parent", "name", "tf_test_parent"),
+					resource.TestCheckNoResourceAttr("dvls_folder.parent", "folder"),
+					resource.TestCheckResourceAttr("dvls_folder.parent", "path", "tf_test_parent"),
+					resource.TestCheckResourceAttr("dvls_folder.child", "name", "tf_test_child"),
+					resource.TestCheckResourceAttr("dvls_folder.child", "folder", "tf_test_parent"),
+					resource.TestCheckResourceAttr("dvls_folder.child", "path", "tf_test_parent\\tf_test_child"),
+					resource.TestCheckResourceAttr("dvls_folder.child", "description", "test description"),
+					resource.TestCheckResourceAttr("dvls_folder.child", "tags.#", "2"),
+					resource.TestCheckResourceAttr("dvls_entry_credential_secret.test", "folder", "tf_test_parent\\tf_test_child"),
+				),
+			},
+			// Update
+			{
+				Config: testAccFolderResourceConfig("tf_test_folder", "tf_test_parent", "tf_test_child_renamed", "updated description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dvls_folder.child", "name", "tf_test_child_renamed"),
+					resource.TestCheckResourceAttr("dvls_folder.child", "path", "tf_test_parent\\tf_test_child_renamed"),
+					resource.TestCheckResourceAttr("dvls_folder.child", "description", "updated description"),
+					resource.TestCheckResourceAttr("dvls_entry_credential_secret.test", "folder", "tf_test_parent\\tf_test_child_renamed"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "dvls_folder.child",
+				ImportState:       true,
+				ImportStateIdFunc: testAccEntryCredentialImportStateIdFunc("dvls_folder.child"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFolderDestroy(s *terraform.State) error {
+	client, err := getTestAccClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dvls_folder" {
+			continue
+		}
+
+		vaultId := rs.Primary.Attributes["vault_id"]
+		entryId := rs.Primary.ID
+
+		_, err := client.Entries.Folder.GetById(vaultId, entryId)
+		if err == nil {
+			return fmt.Errorf("folder %s/%s still exists", vaultId, entryId)
+		}
+
+		if !isNotFound(err) {
+			return fmt.Errorf("unexpected error checking folder %s/%s: %s", vaultId, entryId, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccFolderResourceConfig(vaultName, parentName, childName, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "dvls_vault" "test" {
+  name = %[2]q
+}
+
+resource "dvls_folder" "parent" {
+  vault_id = dvls_vault.test.id
+  name     = %[3]q
+}
+
+resource "dvls_folder" "child" {
+  vault_id    = dvls_vault.test.id
+  name        = %[4]q
+  folder      = dvls_folder.parent.path
+  description = %[5]q
+  tags        = ["tf-test", "acceptance"]
+}
+
+resource "dvls_entry_credential_secret" "test" {
+  vault_id = dvls_vault.test.id
+  name     = "tf_test_secret_in_folder"
+  folder   = dvls_folder.child.path
+  secret   = "my-secret-value-123"
+}
+`, testAccProviderConfig(), vaultName, parentName, childName, description)
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxLoggedBodySize is the maximum number of bytes of a request or response
+// body written to the logs.
+const maxLoggedBodySize = 16 * 1024
+
+const redactedValue = "***"
+
+// sensitiveFieldNames are the parts of the field names whose values are
+// redacted from the logged bodies. Field names are compared in lower case,
+// without underscores and dashes.
+var sensitiveFieldNames = []string{
+	"password",
+	"secret",
+	"apikey",
+	"privatekey",
+	"passphrase",
+	"token",
+	"connectionstring",
+}
+
+var (
+	vaultIdPattern = regexp.MustCompile(`(?i)/vault/([0-9a-f-]{36})`)
+	entryIdPattern = regexp.MustCompile(`(?i)/(?:entry|connections/partial|connections)/([0-9a-f-]{36})`)
+)
+
+// loggingTransport logs every request sent to DVLS and its response at the
+// debug level, with the sensitive values of their bodies redacted.
+type loggingTransport struct {
+	base http.RoundTripper
+}
+
+func newLoggingTransport(base http.RoundTripper) *loggingTransport {
+	return &loggingTransport{base: base}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	fields := map[string]any{
+		"method": req.Method,
+		"path":   req.URL.Path,
+	}
+
+	if m := vaultIdPattern.FindStringSubmatch(req.URL.Path); m != nil {
+		fields["vault_id"] = m[1]
+	}
+
+	if m := entryIdPattern.FindStringSubmatch(req.URL.Path); m != nil {
+		fields["entry_id"] = m[1]
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := readRequestBody(req)
+		if err != nil {
+			return nil, err
+		}
+
+		fields["request_body"] = redactBody(req.Header.Get("Content-Type"), body)
+	}
+
+	tflog.Debug(ctx, "sending DVLS request", fields)
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	delete(fields, "request_body")
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "DVLS request failed", fields)
+		return nil, err
+	}
+
+	fields["status"] = resp.StatusCode
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		fields["error"] = err.Error()
+	} else if len(body) > 0 {
+		fields["response_body"] = redactBody(resp.Header.Get("Content-Type"), body)
+	}
+
+	tflog.Debug(ctx, "received DVLS response", fields)
+
+	return resp, nil
+}
+
+// readRequestBody returns the body of req, which can still be sent afterwards.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+
+		return io.ReadAll(body)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return body, nil
+}
+
+// redactBody returns the body to log, with the values of its sensitive fields
+// redacted. Bodies that are neither JSON nor form encoded are not logged.
+func redactBody(contentType string, body []byte) string {
+	var redacted string
+
+	switch {
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return "<unreadable form body>"
+		}
+
+		for key := range values {
+			if isSensitiveField(key) {
+				values.Set(key, redactedValue)
+			}
+		}
+
+		redacted = values.Encode()
+	default:
+		var value any
+		if err := json.Unmarshal(body, &value); err != nil {
+			return "<non-JSON body>"
+		}
+
+		redactedJson, err := json.Marshal(redactValue(value))
+		if err != nil {
+			return "<unreadable JSON body>"
+		}
+
+		redacted = string(redactedJson)
+	}
+
+	if len(redacted) > maxLoggedBodySize {
+		redacted = redacted[:maxLoggedBodySize] + "...(truncated)"
+	}
+
+	return redacted
+}
+
+// redactValue redacts the sensitive fields of a decoded JSON value. DVLS
+// returns some entry data as a JSON document in a string field, which is
+// redacted as well.
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, fieldValue := range v {
+			if isSensitiveField(key) {
+				if fieldValue != nil && fieldValue != "" {
+					v[key] = redactedValue
+				}
+				continue
+			}
+
+			v[key] = redactValue(fieldValue)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	case string:
+		trimmed := strings.TrimSpace(v)
+		if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+			var nested any
+			if err := json.Unmarshal([]byte(trimmed), &nested); err == nil {
+				if redacted, err := json.Marshal(redactValue(nested)); err == nil {
+					return string(redacted)
+				}
+			}
+		}
+	}
+
+	return value
+}
+
+func isSensitiveField(name string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+
+	for _, sensitive := range sensitiveFieldNames {
+		if strings.Contains(normalized, sensitive) {
+			return true
+		}
+	}
+
+	return false
+}
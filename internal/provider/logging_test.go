@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactBody(t *testing.T) {
+	testCases := map[string]struct {
+		contentType string
+		body        string
+		expected    string
+	}{
+		"json": {
+			contentType: "application/json",
+			body:        `{"name":"foo","data":{"username":"bar","password":"p4ss","api_key":"k3y","clientSecret":"s3cret","privateKeyData":"-----BEGIN","passphrase":"phr4se"}}`,
+			expected:    `{"data":{"api_key":"***","clientSecret":"***","passphrase":"***","password":"***","privateKeyData":"***","username":"bar"},"name":"foo"}`,
+		},
+		"json in a string": {
+			contentType: "application/json",
+			body:        `{"data":"{\"host\":\"foo\",\"password\":\"p4ss\"}"}`,
+			expected:    `{"data":"{\"host\":\"foo\",\"password\":\"***\"}"}`,
+		},
+		"json array": {
+			contentType: "application/json; charset=utf-8",
+			body:        `[{"secret":"s3cret"},{"secret":""}]`,
+			expected:    `[{"secret":"***"},{"secret":""}]`,
+		},
+		"login form": {
+			contentType: "application/x-www-form-urlencoded",
+			body:        "AppKey=foo&AppSecret=s3cret",
+			expected:    "AppKey=foo&AppSecret=%2A%2A%2A",
+		},
+		"login response": {
+			contentType: "application/json",
+			body:        `{"TokenId":"t0ken"}`,
+			expected:    `{"TokenId":"***"}`,
+		},
+		"binary": {
+			contentType: "application/octet-stream",
+			body:        "\x00\x01\x02",
+			expected:    "<non-JSON body>",
+		},
+	}
+
+	for name, testCase := range testCases {
+		if got := redactBody(testCase.contentType, []byte(testCase.body)); got != testCase.expected {
+			t.Errorf("%s: expected %s, got %s", name, testCase.expected, got)
+		}
+	}
+}
+
+func TestLoggingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), "p4ss") {
+			t.Errorf("expected the request body to be sent unchanged, got %s", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"00000000-0000-0000-0000-000000000002","data":{"password":"s3cret"}}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	reqUrl := server.URL + "/api/v1/vault/00000000-0000-0000-0000-000000000001/entry/00000000-0000-0000-0000-000000000002"
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPut, reqUrl, strings.NewReader(`{"data":{"password":"p4ss"}}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := (&http.Client{Transport: newLoggingTransport(http.DefaultTransport)}).Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "s3cret") {
+		t.Errorf("expected the response body to be returned unchanged, got %s", body)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unable to decode logs: %s", err)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 log entries, got %d: %v", len(entries), entries)
+	}
+
+	response := entries[1]
+	for field, expected := range map[string]any{
+		"@level":   "debug",
+		"method":   http.MethodPut,
+		"status":   float64(http.StatusOK),
+		"vault_id": "00000000-0000-0000-0000-000000000001",
+		"entry_id": "00000000-0000-0000-0000-000000000002",
+	} {
+		if response[field] != expected {
+			t.Errorf("expected %s to be %v, got %v", field, expected, response[field])
+		}
+	}
+
+	if _, ok := response["duration_ms"]; !ok {
+		t.Error("expected the duration to be logged")
+	}
+
+	if strings.Contains(output.String(), "p4ss") || strings.Contains(output.String(), "s3cret") {
+		t.Errorf("expected the secrets to be redacted from the logs, got %s", output.String())
+	}
+}
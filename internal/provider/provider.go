@@ -2,18 +2,30 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure DvlsProvider satisfies various provider interfaces.
 var _ provider.Provider = &DvlsProvider{}
+var _ provider.ProviderWithEphemeralResources = &DvlsProvider{}
 
 // DvlsProvider defines the provider implementation.
 type DvlsProvider struct {
@@ -28,6 +40,29 @@ type DvlsProviderModel struct {
 	BaseUri   types.String `tfsdk:"base_uri"`
 	AppId     types.String `tfsdk:"app_id"`
 	AppSecret types.String `tfsdk:"app_secret"`
+
+	AppSecretFile types.String           `tfsdk:"app_secret_file"`
+	Auth          *DvlsProviderAuthModel `tfsdk:"auth"`
+
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait types.String `tfsdk:"retry_min_wait"`
+	RetryMaxWait types.String `tfsdk:"retry_max_wait"`
+
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+
+	CaCertPem          types.String `tfsdk:"ca_cert_pem"`
+	CaCertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+
+	ProxyUrl       types.String `tfsdk:"proxy_url"`
+	NoProxy        types.String `tfsdk:"no_proxy"`
+	RequestTimeout types.String `tfsdk:"request_timeout"`
+
+	CertificateExpiryWarningDays types.Int64 `tfsdk:"certificate_expiry_warning_days"`
+	CertificateFailIfExpired     types.Bool  `tfsdk:"certificate_fail_if_expired"`
 }
 
 func (p *DvlsProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -37,11 +72,12 @@ func (p *DvlsProvider) Metadata(ctx context.Context, req provider.MetadataReques
 
 func (p *DvlsProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "The provider can be configured using the environment variables DVLS_APP_ID and DVLS_APP_SECRET",
+		Description: "The provider can be configured using the environment variables DVLS_BASE_URI, DVLS_APP_ID, DVLS_APP_SECRET, DVLS_TOKEN, DVLS_PROXY_URL, DVLS_NO_PROXY and DVLS_REQUEST_TIMEOUT",
 		Attributes: map[string]schema.Attribute{
 			"base_uri": schema.StringAttribute{
-				Description: "DVLS base URI",
-				Required:    true,
+				MarkdownDescription: "DVLS base URI (ex.: `https://dvls.your-dvls-instance.com/`) `$DVLS_BASE_URI`",
+				Optional:            true,
+				Validators:          []validator.String{baseUriValidator{}},
 			},
 			"app_id": schema.StringAttribute{
 				MarkdownDescription: "DVLS App ID `$DVLS_APP_ID`",
@@ -52,6 +88,104 @@ func (p *DvlsProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"app_secret_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the DVLS App Secret, such as a mounted Kubernetes secret. Conflicts with `app_secret`.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("app_secret"))},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of times a request is retried after a connection error or a `429`, `502`, `503` or `504` response. Requests that create or update data are only retried after a `429`, or a `503` with a `Retry-After` header, so that they are never applied twice. Set to `0` to disable retries. Defaults to `%d`.", defaultMaxRetries),
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"retry_min_wait": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Time to wait before the first retry, doubled on each following retry (ex.: `500ms`, `2s`). Defaults to `%s`.", defaultRetryMinWait),
+				Optional:            true,
+				Validators:          []validator.String{durationValidator{}},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Maximum time to wait between two retries, including the time requested by a `Retry-After` header (ex.: `30s`, `1m`). Defaults to `%s`.", defaultRetryMaxWait),
+				Optional:            true,
+				Validators:          []validator.String{durationValidator{}},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of requests sent to DVLS per second, retries included. Unlimited when not set.",
+				Optional:            true,
+				Validators:          []validator.Float64{float64validator.AtLeast(0)},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests sent to DVLS at the same time, whatever the Terraform parallelism. Unlimited when not set.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate(s) trusted in addition to the system ones to verify the DVLS certificate. Conflicts with `ca_cert_file`.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file"))},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the PEM encoded CA certificate(s) trusted in addition to the system ones to verify the DVLS certificate. Conflicts with `ca_cert_pem`.",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate presented to DVLS for mutual TLS. Requires `client_key`.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("client_key"))},
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the client certificate. Requires `client_cert`.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators:          []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("client_cert"))},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the DVLS certificate. Only use this for testing, the connection is not secure.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy used to reach DVLS (ex.: `http://proxy.example.com:3128`) `$DVLS_PROXY_URL`. Defaults to the `HTTPS_PROXY` and `HTTP_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"no_proxy": schema.StringAttribute{
+				MarkdownDescription: "Comma-separated list of hosts, domains and IP ranges that are reached without the proxy `$DVLS_NO_PROXY`. Defaults to the `NO_PROXY` environment variable.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum time a single request to DVLS can take, including reading its response, before it is canceled (ex.: `30s`, `2m`) `$DVLS_REQUEST_TIMEOUT`. Each retry gets its own timeout. No timeout when not set.",
+				Optional:            true,
+				Validators:          []validator.String{durationValidator{}},
+			},
+			"certificate_expiry_warning_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days before the `expiration` of a certificate entry from which plans report a warning, for the `dvls_entry_certificate` resources and data sources. Overridden by their `expiry_warning_days`. No warning is reported when not set.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"certificate_fail_if_expired": schema.BoolAttribute{
+				MarkdownDescription: "Fail the plan when a `dvls_entry_certificate` resource or data source is expired. Overridden by their `fail_if_expired`. Defaults to `false`.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"auth": schema.SingleNestedBlock{
+				MarkdownDescription: "Selects how the provider authenticates to DVLS. The provider uses the application identity (`app_id` and `app_secret`) when the block is not set.",
+				Attributes: map[string]schema.Attribute{
+					"method": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("Authentication method. Must be one of: [%s]. Defaults to `%s`.", strings.Join(authMethods, ", "), authMethodApplication),
+						Optional:            true,
+						Validators:          []validator.String{stringvalidator.OneOf(authMethods...)},
+					},
+					"token": schema.StringAttribute{
+						MarkdownDescription: "Pre-issued DVLS session token, used with the `token` method `$DVLS_TOKEN`. The provider does not renew the token, it must be valid for the whole run. Conflicts with `app_id`, `app_secret` and `app_secret_file`.",
+						Optional:            true,
+						Sensitive:           true,
+						Validators: []validator.String{stringvalidator.ConflictsWith(
+							path.MatchRoot("app_id"),
+							path.MatchRoot("app_secret"),
+							path.MatchRoot("app_secret_file"),
+						)},
+					},
+				},
+			},
 		},
 	}
 }
@@ -65,10 +199,35 @@ func (p *DvlsProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
-	baseuri := data.BaseUri.ValueString()
+	// The client cannot be created while the configuration has unknown values,
+	// for instance when the app secret comes from a resource that has not been
+	// created yet.
+	if !req.Config.Raw.IsFullyKnown() {
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &provider.Deferred{
+				Reason: provider.DeferredReasonProviderConfigUnknown,
+			}
+			return
+		}
+
+		providerData := &dvlsClient{
+			certificateExpiry: newCertificateExpiry(data),
+		}
+
+		resp.DataSourceData = providerData
+		resp.ResourceData = providerData
+		resp.EphemeralResourceData = providerData
+		return
+	}
+
+	baseUri := os.Getenv("DVLS_BASE_URI")
 	appId := os.Getenv("DVLS_APP_ID")
 	appSecret := os.Getenv("DVLS_APP_SECRET")
 
+	if !data.BaseUri.IsNull() {
+		baseUri = data.BaseUri.ValueString()
+	}
+
 	if !data.AppId.IsNull() {
 		appId = data.AppId.ValueString()
 	}
@@ -77,19 +236,179 @@ func (p *DvlsProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		appSecret = data.AppSecret.ValueString()
 	}
 
-	if appId == "" || appSecret == "" {
-		resp.Diagnostics.AddError("unable to set up dvls client", "'app_id' and 'app_secret' cannot be empty")
+	if baseUri == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("base_uri"), "missing DVLS base URI", "'base_uri' must be set in the provider configuration or with the DVLS_BASE_URI environment variable")
+	} else if err := validateBaseUri(baseUri); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("base_uri"), "invalid DVLS base URI", err.Error())
+	}
+
+	authMethod := authMethodApplication
+	token := os.Getenv("DVLS_TOKEN")
+
+	if data.Auth != nil {
+		if !data.Auth.Method.IsNull() {
+			authMethod = data.Auth.Method.ValueString()
+		}
+
+		if !data.Auth.Token.IsNull() {
+			token = data.Auth.Token.ValueString()
+		}
+	}
+
+	switch authMethod {
+	case authMethodToken:
+		if token == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("auth").AtName("token"), "missing DVLS token", "'token' must be set in the auth block or with the DVLS_TOKEN environment variable when the auth method is 'token'")
+		}
+
+		for _, attribute := range []struct {
+			name  string
+			value types.String
+		}{
+			{"app_id", data.AppId},
+			{"app_secret", data.AppSecret},
+			{"app_secret_file", data.AppSecretFile},
+		} {
+			if !attribute.value.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root(attribute.name), "conflicting authentication settings", fmt.Sprintf("'%s' cannot be set when the auth method is 'token'", attribute.name))
+			}
+		}
+	default:
+		if data.Auth != nil && !data.Auth.Token.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("auth").AtName("token"), "conflicting authentication settings", "'token' can only be set when the auth method is 'token'")
+		}
+
+		if appId == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("app_id"), "missing DVLS app ID", "'app_id' must be set in the provider configuration or with the DVLS_APP_ID environment variable")
+		}
+
+		if !data.AppSecretFile.IsNull() {
+			secret, err := os.ReadFile(data.AppSecretFile.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("app_secret_file"), "unable to read app secret file", err.Error())
+			} else if appSecret = strings.TrimSpace(string(secret)); appSecret == "" {
+				resp.Diagnostics.AddAttributeError(path.Root("app_secret_file"), "missing DVLS app secret", "the app secret file is empty")
+			}
+		} else if appSecret == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("app_secret"), "missing DVLS app secret", "'app_secret' or 'app_secret_file' must be set in the provider configuration, or the secret set with the DVLS_APP_SECRET environment variable")
+		}
+	}
+
+	maxRetries := defaultMaxRetries
+	retryMinWait := defaultRetryMinWait
+	retryMaxWait := defaultRetryMaxWait
+
+	if !data.MaxRetries.IsNull() {
+		maxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	if !data.RetryMinWait.IsNull() {
+		retryMinWait, _ = time.ParseDuration(data.RetryMinWait.ValueString())
+	}
+
+	if !data.RetryMaxWait.IsNull() {
+		retryMaxWait, _ = time.ParseDuration(data.RetryMaxWait.ValueString())
+	}
+
+	if retryMinWait > retryMaxWait {
+		resp.Diagnostics.AddAttributeError(path.Root("retry_min_wait"), "invalid retry wait", "'retry_min_wait' cannot be greater than 'retry_max_wait'")
+	}
+
+	caCertPem := data.CaCertPem.ValueString()
+	caCertPath := path.Root("ca_cert_pem")
+
+	if !data.CaCertFile.IsNull() {
+		caCertPath = path.Root("ca_cert_file")
+
+		caCert, err := os.ReadFile(data.CaCertFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(caCertPath, "unable to read CA certificate file", err.Error())
+		}
+
+		caCertPem = string(caCert)
+	}
+
+	tlsConfig, err := newTLSConfig(caCertPem, data.ClientCert.ValueString(), data.ClientKey.ValueString(), data.InsecureSkipVerify.ValueBool())
+	if errors.Is(err, errInvalidCaCert) {
+		resp.Diagnostics.AddAttributeError(caCertPath, "invalid CA certificate", err.Error())
+	} else if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("client_cert"), "invalid client certificate", err.Error())
+	}
+
+	proxyUrl := os.Getenv("DVLS_PROXY_URL")
+	noProxy := os.Getenv("DVLS_NO_PROXY")
+	requestTimeout := os.Getenv("DVLS_REQUEST_TIMEOUT")
+
+	if !data.ProxyUrl.IsNull() {
+		proxyUrl = data.ProxyUrl.ValueString()
+	}
+
+	if !data.NoProxy.IsNull() {
+		noProxy = data.NoProxy.ValueString()
+	}
+
+	if !data.RequestTimeout.IsNull() {
+		requestTimeout = data.RequestTimeout.ValueString()
+	}
+
+	proxyConfig, err := newProxyConfig(proxyUrl, noProxy)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("proxy_url"), "invalid proxy URL", err.Error())
+	}
+
+	var timeout time.Duration
+	if requestTimeout != "" {
+		timeout, err = time.ParseDuration(requestTimeout)
+		if err != nil || timeout < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("request_timeout"), "invalid request timeout", fmt.Sprintf("%q is not a valid duration (ex.: 30s, 2m)", requestTimeout))
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// go-dvls sends its requests through http.DefaultTransport, which sends
+	// the requests to baseUri through this transport (see hostTransports).
+	// Each attempt of a retried request goes through the limits, is logged
+	// and has its own timeout.
+	timed := newTimeoutTransport(newHTTPTransport(tlsConfig, proxyConfig), timeout)
+	limited := newLimitTransport(newLoggingTransport(timed), data.RequestsPerSecond.ValueFloat64(), int(data.MaxConcurrentRequests.ValueInt64()))
+	var transport http.RoundTripper = newRetryTransport(limited, maxRetries, retryMinWait, retryMaxWait)
+
+	if authMethod == authMethodToken {
+		transport = newTokenLoginTransport(transport, token)
+		appId, appSecret = "", ""
+	}
+
+	if err := registerDvlsTransport(baseUri, transport); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("base_uri"), "invalid DVLS base URI", err.Error())
 		return
 	}
 
-	dvlsClient, err := dvls.NewClient(appId, appSecret, baseuri)
+	client, err := dvls.NewClient(appId, appSecret, baseUri)
 	if err != nil {
-		resp.Diagnostics.AddError("unable to set up dvls client", err.Error())
+		addDvlsError(&resp.Diagnostics, "unable to set up dvls client", err)
 		return
 	}
 
-	resp.DataSourceData = &dvlsClient
-	resp.ResourceData = &dvlsClient
+	if authMethod == authMethodToken {
+		if err := verifyToken(ctx, &client, baseUri); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("auth").AtName("token"), "unable to set up dvls client", err.Error())
+			return
+		}
+	}
+
+	providerData := &dvlsClient{
+		Client:  &client,
+		baseUri: baseUri,
+
+		certificateExpiry: newCertificateExpiry(data),
+	}
+
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+	resp.EphemeralResourceData = providerData
 }
 
 func (p *DvlsProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -101,12 +420,16 @@ func (p *DvlsProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewEntryCredentialSecretResource,
 		NewEntryCredentialSSHKeyResource,
 		NewEntryCredentialUsernamePasswordResource,
+		NewEntryHostResource,
+		NewEntryWebsiteResource,
+		NewFolderResource,
 		NewVaultResource,
 	}
 }
 
 func (p *DvlsProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewEntriesDataSource,
 		NewEntryCertificateDataSource,
 		NewEntryCredentialApiKeyDataSource,
 		NewEntryCredentialAzureServicePrincipalDataSource,
@@ -116,7 +439,21 @@ func (p *DvlsProvider) DataSources(ctx context.Context) []func() datasource.Data
 		NewEntryCredentialUsernamePasswordDataSource,
 		NewEntryHostDataSource,
 		NewEntryWebsiteDataSource,
+		NewExpiringCertificatesDataSource,
+		NewFolderDataSource,
 		NewVaultDataSource,
+		NewVaultsDataSource,
+	}
+}
+
+func (p *DvlsProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewEntryCredentialApiKeyEphemeralResource,
+		NewEntryCredentialAzureServicePrincipalEphemeralResource,
+		NewEntryCredentialConnectionStringEphemeralResource,
+		NewEntryCredentialSecretEphemeralResource,
+		NewEntryCredentialSSHKeyEphemeralResource,
+		NewEntryCredentialUsernamePasswordEphemeralResource,
 	}
 }
 
@@ -1,14 +1,22 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"sync"
 	"testing"
 
 	"github.com/Devolutions/go-dvls"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -17,6 +25,13 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"dvls": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testAccProtoV6ProviderFactoriesWithEcho adds the echo provider, which exposes
+// ephemeral resource values in state so they can be asserted by the tests.
+var testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+	"dvls": providerserver.NewProtocol6WithError(New("test")()),
+	"echo": echoprovider.NewProviderServer(),
+}
+
 var (
 	testAccClient     *dvls.Client
 	testAccClientOnce sync.Once
@@ -91,7 +106,7 @@ func testAccCheckVaultDestroy(s *terraform.State) error {
 			return fmt.Errorf("vault %s still exists", rs.Primary.ID)
 		}
 
-		if !dvls.IsNotFound(err) {
+		if !isNotFound(err) {
 			return fmt.Errorf("unexpected error checking vault %s: %s", rs.Primary.ID, err)
 		}
 	}
@@ -127,10 +142,152 @@ func testAccCheckEntryCredentialDestroy(s *terraform.State) error {
 			return fmt.Errorf("entry %s/%s still exists", vaultId, entryId)
 		}
 
-		if !dvls.IsNotFound(err) {
+		if !isNotFound(err) {
 			return fmt.Errorf("unexpected error checking entry %s/%s: %s", vaultId, entryId, err)
 		}
 	}
 
 	return nil
 }
+
+// testProviderConfigureRequest returns a provider configure request with the
+// given attribute values, all other attributes being null.
+func testProviderConfigureRequest(t *testing.T, p provider.Provider, values map[string]tftypes.Value) provider.ConfigureRequest {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		}
+	}
+
+	return provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, attributes),
+		},
+	}
+}
+
+func TestDvlsProvider_ConfigureMissingSettings(t *testing.T) {
+	t.Setenv("DVLS_BASE_URI", "")
+	t.Setenv("DVLS_APP_ID", "")
+	t.Setenv("DVLS_APP_SECRET", "")
+
+	p := New("test")()
+
+	var resp provider.ConfigureResponse
+	p.Configure(context.Background(), testProviderConfigureRequest(t, p, nil), &resp)
+
+	for _, attribute := range []string{"base_uri", "app_id", "app_secret"} {
+		if !testHasAttributeError(resp.Diagnostics, path.Root(attribute)) {
+			t.Errorf("expected an error on %s, got %v", attribute, resp.Diagnostics)
+		}
+	}
+}
+
+func TestDvlsProvider_ConfigureInvalidSettings(t *testing.T) {
+	t.Setenv("DVLS_BASE_URI", "dvls.example.com")
+	t.Setenv("DVLS_APP_ID", "00000000-0000-0000-0000-000000000000")
+	t.Setenv("DVLS_APP_SECRET", "secret")
+	t.Setenv("DVLS_REQUEST_TIMEOUT", "soon")
+
+	p := New("test")()
+
+	var resp provider.ConfigureResponse
+	p.Configure(context.Background(), testProviderConfigureRequest(t, p, map[string]tftypes.Value{
+		"retry_min_wait": tftypes.NewValue(tftypes.String, "1m"),
+		"retry_max_wait": tftypes.NewValue(tftypes.String, "1s"),
+		"ca_cert_pem":    tftypes.NewValue(tftypes.String, "not a certificate"),
+		"proxy_url":      tftypes.NewValue(tftypes.String, "proxy.example.com"),
+	}), &resp)
+
+	for _, attribute := range []string{"base_uri", "retry_min_wait", "ca_cert_pem", "proxy_url", "request_timeout"} {
+		if !testHasAttributeError(resp.Diagnostics, path.Root(attribute)) {
+			t.Errorf("expected an error on %s, got %v", attribute, resp.Diagnostics)
+		}
+	}
+
+	for _, attribute := range []string{"app_id", "app_secret"} {
+		if testHasAttributeError(resp.Diagnostics, path.Root(attribute)) {
+			t.Errorf("expected no error on %s, got %v", attribute, resp.Diagnostics)
+		}
+	}
+}
+
+func TestValidateBaseUri(t *testing.T) {
+	for _, baseUri := range []string{"https://dvls.example.com/", "http://localhost:5000", "https://example.com/dvls"} {
+		if err := validateBaseUri(baseUri); err != nil {
+			t.Errorf("expected %q to be valid, got %s", baseUri, err)
+		}
+	}
+
+	for _, baseUri := range []string{"dvls.example.com", "/dvls", "ftp://dvls.example.com", "https://", "://dvls"} {
+		if err := validateBaseUri(baseUri); err == nil {
+			t.Errorf("expected %q to be invalid", baseUri)
+		}
+	}
+}
+
+func testHasAttributeError(diags diag.Diagnostics, attributePath path.Path) bool {
+	for _, d := range diags.Errors() {
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok && withPath.Path().Equal(attributePath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestDvlsProvider_ConfigureUnknownSettings(t *testing.T) {
+	p := New("test")()
+
+	values := map[string]tftypes.Value{
+		"base_uri":   tftypes.NewValue(tftypes.String, "https://dvls.example.com"),
+		"app_id":     tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000000"),
+		"app_secret": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	}
+
+	req := testProviderConfigureRequest(t, p, values)
+	req.ClientCapabilities.DeferralAllowed = true
+
+	var resp provider.ConfigureResponse
+	p.Configure(context.Background(), req, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	if resp.Deferred == nil || resp.Deferred.Reason != provider.DeferredReasonProviderConfigUnknown {
+		t.Errorf("expected the provider to be deferred, got %v", resp.Deferred)
+	}
+
+	resp = provider.ConfigureResponse{}
+	p.Configure(context.Background(), testProviderConfigureRequest(t, p, values), &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	client, ok := resp.DataSourceData.(*dvlsClient)
+	if !ok || client.configured() {
+		t.Fatalf("expected an unconfigured client, got %v", resp.DataSourceData)
+	}
+
+	var readResp datasource.ReadResponse
+	dataSource := &VaultDataSource{client: client}
+	dataSource.Read(context.Background(), datasource.ReadRequest{}, &readResp)
+
+	if !readResp.Diagnostics.HasError() {
+		t.Error("expected the data source to report that the provider is not configured")
+	}
+}
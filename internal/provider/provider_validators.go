@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type durationValidator struct{}
+
+func (validator durationValidator) Description(_ context.Context) string {
+	return "value must be a valid duration (ex.: 500ms, 30s, 1m)"
+}
+
+func (validator durationValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (d durationValidator) ValidateString(_ context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(request.ConfigValue.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(request.Path, "value is not a valid duration (ex.: 500ms, 30s, 1m)", err.Error())
+		return
+	}
+
+	if duration < 0 {
+		response.Diagnostics.AddAttributeError(request.Path, "value is not a valid duration (ex.: 500ms, 30s, 1m)", "duration cannot be negative")
+		return
+	}
+}
+
+type baseUriValidator struct{}
+
+func (validator baseUriValidator) Description(_ context.Context) string {
+	return "value must be an absolute http or https URL (ex.: https://dvls.your-dvls-instance.com/)"
+}
+
+func (validator baseUriValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (d baseUriValidator) ValidateString(_ context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateBaseUri(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(request.Path, "invalid DVLS base URI", err.Error())
+		return
+	}
+}
+
+// validateBaseUri returns an error if baseUri is not an absolute http or https
+// URL.
+func validateBaseUri(baseUri string) error {
+	u, err := url.Parse(baseUri)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid URL: %w", baseUri, err)
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%q must be an absolute http or https URL (ex.: https://dvls.your-dvls-instance.com/)", baseUri)
+	}
+
+	return nil
+}
@@ -0,0 +1,432 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// defaultTransport is the transport in place before the provider is
+// configured. go-dvls builds its http.Client without a transport, so every
+// request goes through http.DefaultTransport; the provider replaces it with
+// hostTransports, which falls back to this one.
+var defaultTransport = http.DefaultTransport
+
+// hostTransports is installed as http.DefaultTransport by the configured
+// providers. The http.Client of go-dvls is unexported and has no transport,
+// so replacing the process-wide transport is the only way to apply the
+// provider settings to its requests. To keep the settings of a provider
+// instance, such as its TLS configuration and session token, from applying to
+// the other instances of the process and to unrelated requests, each instance
+// registers its transport for the host of its base URI, and the requests to
+// any other host go through defaultTransport.
+var hostTransports = &hostTransport{transports: map[string]http.RoundTripper{}}
+
+// hostTransport sends each request through the transport registered for the
+// scheme and host of its URL, or through defaultTransport when there is none.
+type hostTransport struct {
+	mu         sync.RWMutex
+	transports map[string]http.RoundTripper
+}
+
+// registerDvlsTransport sends the requests to the host of baseUri through
+// transport, and installs hostTransports as http.DefaultTransport. Provider
+// instances configured with the same host share the transport registered
+// last.
+func registerDvlsTransport(baseUri string, transport http.RoundTripper) error {
+	u, err := url.Parse(baseUri)
+	if err != nil {
+		return fmt.Errorf("invalid base uri %q: %w", baseUri, err)
+	}
+
+	hostTransports.mu.Lock()
+	defer hostTransports.mu.Unlock()
+
+	hostTransports.transports[hostTransportKey(u)] = transport
+
+	if http.DefaultTransport != http.RoundTripper(hostTransports) {
+		http.DefaultTransport = hostTransports
+	}
+
+	return nil
+}
+
+func (t *hostTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.RLock()
+	transport, ok := t.transports[hostTransportKey(req.URL)]
+	t.mu.RUnlock()
+
+	if !ok {
+		transport = defaultTransport
+	}
+
+	return transport.RoundTrip(req)
+}
+
+// hostTransportKey returns the scheme and host of u, which identify the DVLS
+// instance the requests are sent to.
+func hostTransportKey(u *url.URL) string {
+	return strings.ToLower(u.Scheme + "://" + u.Host)
+}
+
+// newHTTPTransport returns a copy of the default transport that uses the given
+// TLS and proxy configurations.
+func newHTTPTransport(tlsConfig *tls.Config, proxyConfig *httpproxy.Config) *http.Transport {
+	proxy := proxyConfig.ProxyFunc()
+
+	transport := defaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.Proxy = func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}
+
+	return transport
+}
+
+// newProxyConfig returns the proxy configuration read from the HTTP_PROXY,
+// HTTPS_PROXY and NO_PROXY environment variables, overridden by proxyUrl and
+// noProxy when they are set.
+func newProxyConfig(proxyUrl, noProxy string) (*httpproxy.Config, error) {
+	proxyConfig := httpproxy.FromEnvironment()
+
+	if proxyUrl != "" {
+		u, err := url.Parse(proxyUrl)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid proxy url %q, expected a url such as http://proxy.example.com:3128", proxyUrl)
+		}
+
+		proxyConfig.HTTPProxy = proxyUrl
+		proxyConfig.HTTPSProxy = proxyUrl
+	}
+
+	if noProxy != "" {
+		proxyConfig.NoProxy = noProxy
+	}
+
+	return proxyConfig, nil
+}
+
+var errInvalidCaCert = errors.New("no valid PEM certificate found in the CA certificate")
+
+// newTLSConfig returns the TLS configuration used to connect to DVLS. caCertPem
+// is added to the system certificate pool, and clientCertPem and clientKeyPem
+// are presented to the server when set.
+func newTLSConfig(caCertPem, clientCertPem, clientKeyPem string, insecureSkipVerify bool) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecureSkipVerify,
+	}
+
+	if caCertPem != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM([]byte(caCertPem)) {
+			return nil, errInvalidCaCert
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if clientCertPem != "" || clientKeyPem != "" {
+		cert, err := tls.X509KeyPair([]byte(clientCertPem), []byte(clientKeyPem))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryMinWait = 1 * time.Second
+	defaultRetryMaxWait = 30 * time.Second
+)
+
+// retryTransport retries the requests that fail with a transient error: a
+// connection error or a 429, 502, 503 or 504 status code. See isRetryable for
+// the requests that are not idempotent.
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	minWait    time.Duration
+	maxWait    time.Duration
+}
+
+func newRetryTransport(base http.RoundTripper, maxRetries int, minWait, maxWait time.Duration) *retryTransport {
+	return &retryTransport{
+		base:       base,
+		maxRetries: maxRetries,
+		minWait:    minWait,
+		maxWait:    maxWait,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+
+		if attempt >= t.maxRetries || !isRetryable(req, resp, err) {
+			return resp, err
+		}
+
+		// The body of the request has been consumed by the failed attempt and
+		// cannot be sent again.
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// isRetryable reports whether a request failed with a transient error. A
+// request that is not idempotent may have been processed by DVLS before its
+// response was lost, so it is only retried when DVLS rejected it without
+// processing it: on a 429, or on a 503 that asks to retry with Retry-After.
+func isRetryable(req *http.Request, resp *http.Response, err error) bool {
+	idempotent := isIdempotent(req.Method)
+
+	if err != nil {
+		return idempotent && req.Context().Err() == nil
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusServiceUnavailable:
+		return idempotent || resp.Header.Get("Retry-After") != ""
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent
+	}
+
+	return false
+}
+
+// isIdempotent reports whether sending a request with method twice has the
+// same effect as sending it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// backoff returns the time to wait before the next attempt. It doubles the
+// minimum wait on each attempt, with jitter, and uses the Retry-After header
+// of the response instead when there is one. The wait never exceeds maxWait.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, t.maxWait)
+		}
+	}
+
+	wait := t.minWait << attempt
+	if wait <= 0 || wait > t.maxWait {
+		wait = t.maxWait
+	}
+
+	// Wait between half and all of the computed time so that the concurrent
+	// requests do not retry all at once.
+	if half := wait / 2; half > 0 {
+		wait = half + rand.N(half+1)
+	}
+
+	return wait
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+// limitTransport caps the rate and the number of concurrent requests sent to
+// DVLS. A zero requestsPerSecond or maxConcurrentRequests disables the
+// corresponding limit.
+type limitTransport struct {
+	base http.RoundTripper
+
+	interval time.Duration
+	mu       sync.Mutex
+	next     time.Time
+
+	slots chan struct{}
+}
+
+func newLimitTransport(base http.RoundTripper, requestsPerSecond float64, maxConcurrentRequests int) *limitTransport {
+	t := &limitTransport{base: base}
+
+	if requestsPerSecond > 0 {
+		t.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+
+	if maxConcurrentRequests > 0 {
+		t.slots = make(chan struct{}, maxConcurrentRequests)
+	}
+
+	return t
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if wait := t.reserve(); wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			t.release()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		t.release()
+		return nil, err
+	}
+
+	// The request holds its slot until its response has been read.
+	if t.slots != nil {
+		resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: t.release}
+	}
+
+	return resp, nil
+}
+
+// reserve returns how long to wait before sending the next request so that
+// the requests are evenly spaced.
+func (t *limitTransport) reserve() time.Duration {
+	if t.interval == 0 {
+		return 0
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	if t.next.Before(now) {
+		t.next = now
+	}
+
+	wait := t.next.Sub(now)
+	t.next = t.next.Add(t.interval)
+
+	return wait
+}
+
+func (t *limitTransport) release() {
+	if t.slots != nil {
+		<-t.slots
+	}
+}
+
+// timeoutTransport cancels the requests that do not complete within timeout,
+// including the time to read the response body.
+type timeoutTransport struct {
+	base    http.RoundTripper
+	timeout time.Duration
+}
+
+func newTimeoutTransport(base http.RoundTripper, timeout time.Duration) *timeoutTransport {
+	return &timeoutTransport{
+		base:    base,
+		timeout: timeout,
+	}
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: cancel}
+
+	return resp, nil
+}
+
+// releaseOnClose calls release once, when the body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
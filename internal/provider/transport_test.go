@@ -0,0 +1,705 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+func TestRetryTransport_retriesTransientErrors(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		var calls atomic.Int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) < 3 {
+				w.WriteHeader(status)
+				return
+			}
+
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond, 10*time.Millisecond)}
+
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("status %d: unexpected error: %s", status, err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("status %d: expected status 200, got %d", status, resp.StatusCode)
+		}
+
+		if got := calls.Load(); got != 3 {
+			t.Errorf("status %d: expected 3 calls, got %d", status, got)
+		}
+
+		server.Close()
+	}
+}
+
+func TestRetryTransport_stopsAfterMaxRetries(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 2, time.Millisecond, 10*time.Millisecond)}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status 503, got %d", resp.StatusCode)
+	}
+
+	if got := calls.Load(); got != 3 {
+		t.Errorf("expected 3 calls, got %d", got)
+	}
+}
+
+func TestRetryTransport_doesNotRetryOtherErrors(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond, 10*time.Millisecond)}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected 1 call, got %d", got)
+	}
+}
+
+func TestRetryTransport_resendsBody(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"name":"foo"}` {
+			t.Errorf("unexpected body on call %d: %q", calls.Load()+1, body)
+		}
+
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond, 10*time.Millisecond)}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"foo"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected 2 calls, got %d", got)
+	}
+}
+
+func TestRetryTransport_doesNotResendNonIdempotentRequests(t *testing.T) {
+	tests := map[string]struct {
+		status     int
+		retryAfter string
+		wantCalls  int32
+	}{
+		"bad gateway":                     {status: http.StatusBadGateway, wantCalls: 1},
+		"gateway timeout":                 {status: http.StatusGatewayTimeout, wantCalls: 1},
+		"service unavailable":             {status: http.StatusServiceUnavailable, wantCalls: 1},
+		"service unavailable retry after": {status: http.StatusServiceUnavailable, retryAfter: "0", wantCalls: 2},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if calls.Add(1) == 1 {
+					if test.retryAfter != "" {
+						w.Header().Set("Retry-After", test.retryAfter)
+					}
+					w.WriteHeader(test.status)
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond, 10*time.Millisecond)}
+
+			resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"foo"}`))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp.Body.Close()
+
+			if got := calls.Load(); got != test.wantCalls {
+				t.Errorf("expected %d call(s), got %d", test.wantCalls, got)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_doesNotResendNonIdempotentRequestsOnConnectionErrors(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		conn, _, _ := w.(http.Hijacker).Hijack()
+		conn.Close()
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond, 10*time.Millisecond)}
+
+	_, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"foo"}`))
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected 1 call, got %d", got)
+	}
+}
+
+func TestRetryTransport_retriesConnectionErrors(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond, 10*time.Millisecond)}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+}
+
+func TestRetryTransport_honorsRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	var retriedAt time.Time
+
+	start := time.Now()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		retriedAt = time.Now()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond, 10*time.Second)}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if wait := retriedAt.Sub(start); wait < time.Second {
+		t.Errorf("expected the retry to wait for the Retry-After delay, waited %s", wait)
+	}
+}
+
+func TestRetryTransport_stopsWhenContextIsCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Minute, time.Minute)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+
+	_, err := client.Do(req)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestRetryTransport_backoff(t *testing.T) {
+	transport := newRetryTransport(http.DefaultTransport, 10, time.Second, 10*time.Second)
+
+	for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		wait := transport.backoff(attempt, nil)
+		if wait < expected/2 || wait > expected {
+			t.Errorf("attempt %d: expected a wait between %s and %s, got %s", attempt, expected/2, expected, wait)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"60"}}}
+	if wait := transport.backoff(0, resp); wait != 10*time.Second {
+		t.Errorf("expected the Retry-After delay to be capped to 10s, got %s", wait)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if wait, ok := parseRetryAfter("5"); !ok || wait != 5*time.Second {
+		t.Errorf("expected 5s, got %s (%t)", wait, ok)
+	}
+
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if wait, ok := parseRetryAfter(date); !ok || wait <= 0 || wait > time.Minute {
+		t.Errorf("expected a wait of up to 1m, got %s (%t)", wait, ok)
+	}
+
+	if _, ok := parseRetryAfter(""); ok {
+		t.Error("expected an empty value to be ignored")
+	}
+
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Error("expected an invalid value to be ignored")
+	}
+}
+
+func TestLimitTransport_capsConcurrentRequests(t *testing.T) {
+	var current, highest atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+
+		for {
+			h := highest.Load()
+			if n <= h || highest.CompareAndSwap(h, n) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newLimitTransport(http.DefaultTransport, 0, 2)}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		})
+	}
+	wg.Wait()
+
+	if got := highest.Load(); got > 2 {
+		t.Errorf("expected at most 2 concurrent requests, got %d", got)
+	}
+}
+
+func TestLimitTransport_capsRequestRate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newLimitTransport(http.DefaultTransport, 50, 0)}
+
+	start := time.Now()
+
+	for range 6 {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+
+	// The first request is sent right away and the 5 others 20ms apart.
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("expected 6 requests at 50 requests per second to take at least 100ms, took %s", elapsed)
+	}
+}
+
+func TestLimitTransport_stopsWhenContextIsCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	transport := newLimitTransport(http.DefaultTransport, 0, 1)
+	client := &http.Client{Transport: transport}
+
+	// Hold the only slot by not closing the response body.
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+
+	if _, err := client.Do(req); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	resp.Body.Close()
+
+	if len(transport.slots) != 0 {
+		t.Errorf("expected all slots to be released, %d still held", len(transport.slots))
+	}
+}
+
+func TestNewTLSConfig_caCert(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caCertPem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	untrusted, err := newTLSConfig("", "", "", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := (&http.Client{Transport: newHTTPTransport(untrusted, &httpproxy.Config{})}).Get(server.URL); err == nil {
+		t.Error("expected the server certificate to be rejected without its CA")
+	}
+
+	trusted, err := newTLSConfig(caCertPem, "", "", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := (&http.Client{Transport: newHTTPTransport(trusted, &httpproxy.Config{})}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the server certificate to be trusted with its CA: %s", err)
+	}
+	resp.Body.Close()
+}
+
+func TestNewTLSConfig_insecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	tlsConfig, err := newTLSConfig("", "", "", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := (&http.Client{Transport: newHTTPTransport(tlsConfig, &httpproxy.Config{})}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the server certificate not to be verified: %s", err)
+	}
+	resp.Body.Close()
+}
+
+func TestNewTLSConfig_clientCert(t *testing.T) {
+	clientCertPem, clientKeyPem := testGenerateCertificate(t)
+
+	clientCert, err := tls.X509KeyPair([]byte(clientCertPem), []byte(clientKeyPem))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert.Leaf)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+
+	caCertPem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	withoutCert, err := newTLSConfig(caCertPem, "", "", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := (&http.Client{Transport: newHTTPTransport(withoutCert, &httpproxy.Config{})}).Get(server.URL); err == nil {
+		t.Error("expected the request to be rejected without a client certificate")
+	}
+
+	withCert, err := newTLSConfig(caCertPem, clientCertPem, clientKeyPem, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := (&http.Client{Transport: newHTTPTransport(withCert, &httpproxy.Config{})}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the client certificate to be accepted: %s", err)
+	}
+	resp.Body.Close()
+}
+
+func TestNewTLSConfig_invalid(t *testing.T) {
+	if _, err := newTLSConfig("not a certificate", "", "", false); err == nil {
+		t.Error("expected an error for an invalid CA certificate")
+	}
+
+	clientCertPem, _ := testGenerateCertificate(t)
+	_, otherKeyPem := testGenerateCertificate(t)
+
+	if _, err := newTLSConfig("", clientCertPem, otherKeyPem, false); err == nil {
+		t.Error("expected an error for a client key that does not match the certificate")
+	}
+}
+
+// testGenerateCertificate returns a PEM encoded self-signed client certificate
+// and its private key.
+func testGenerateCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-provider-dvls"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unable to create certificate: %s", err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unable to marshal key: %s", err)
+	}
+
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+
+	return string(certPem), string(keyPem)
+}
+
+func TestNewProxyConfig(t *testing.T) {
+	t.Setenv("HTTPS_PROXY", "http://env-proxy.example.com:3128")
+	t.Setenv("NO_PROXY", "env.example.com")
+
+	proxyConfig, err := newProxyConfig("", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if proxyConfig.HTTPSProxy != "http://env-proxy.example.com:3128" || proxyConfig.NoProxy != "env.example.com" {
+		t.Errorf("expected the environment proxy settings, got %+v", proxyConfig)
+	}
+
+	proxyConfig, err = newProxyConfig("http://proxy.example.com:3128", "dvls.example.com,.internal")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	proxy := proxyConfig.ProxyFunc()
+
+	proxyUrl, err := proxy(&url.URL{Scheme: "https", Host: "dvls.other.com"})
+	if err != nil || proxyUrl == nil || proxyUrl.Host != "proxy.example.com:3128" {
+		t.Errorf("expected the request to go through the proxy, got %v (%v)", proxyUrl, err)
+	}
+
+	for _, host := range []string{"dvls.example.com", "dvls.internal"} {
+		proxyUrl, err := proxy(&url.URL{Scheme: "https", Host: host})
+		if err != nil || proxyUrl != nil {
+			t.Errorf("expected the request to %s not to go through the proxy, got %v (%v)", host, proxyUrl, err)
+		}
+	}
+
+	if _, err := newProxyConfig("proxy.example.com", ""); err == nil {
+		t.Error("expected an error for a proxy url without a scheme")
+	}
+}
+
+func TestNewHTTPTransport_proxy(t *testing.T) {
+	var proxied atomic.Bool
+
+	proxyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied.Store(r.URL.Host == "dvls.example.com")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxyServer.Close()
+
+	proxyConfig, err := newProxyConfig(proxyServer.URL, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := &http.Client{Transport: newHTTPTransport(nil, proxyConfig)}
+
+	resp, err := client.Get("http://dvls.example.com/api/is-logged")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if !proxied.Load() {
+		t.Error("expected the request to go through the proxy")
+	}
+}
+
+func TestTimeoutTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newTimeoutTransport(http.DefaultTransport, 50*time.Millisecond)}
+
+	start := time.Now()
+	if _, err := client.Get(server.URL + "/slow"); err == nil {
+		t.Error("expected the slow request to time out")
+	}
+
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected the slow request to be canceled after 50ms, took %s", elapsed)
+	}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	// The body can still be read once RoundTrip has returned.
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || string(body) != "ok" {
+		t.Errorf("expected the body to be read, got %q (%v)", body, err)
+	}
+}
+
+func TestHostTransport(t *testing.T) {
+	newServer := func() *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			w.Write([]byte("sent " + string(body)))
+		}))
+	}
+
+	first, second, other := newServer(), newServer(), newServer()
+	defer first.Close()
+	defer second.Close()
+	defer other.Close()
+
+	t.Cleanup(func() {
+		hostTransports.mu.Lock()
+		clear(hostTransports.transports)
+		hostTransports.mu.Unlock()
+
+		http.DefaultTransport = defaultTransport
+	})
+
+	for _, server := range []struct {
+		url   string
+		token string
+	}{
+		{first.URL, "first-token"},
+		{second.URL, "second-token"},
+	} {
+		if err := registerDvlsTransport(server.url+"/dvls/", newTokenLoginTransport(defaultTransport, server.token)); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if http.DefaultTransport != http.RoundTripper(hostTransports) {
+		t.Fatalf("expected the host transport to be installed")
+	}
+
+	tests := map[string]string{
+		first.URL:  `{"TokenId":"first-token"}`,
+		second.URL: `{"TokenId":"second-token"}`,
+		other.URL:  `sent {"userName":"app"}`,
+	}
+
+	for serverUrl, want := range tests {
+		resp, err := http.Post(serverUrl+loginEndpoint, "application/json", strings.NewReader(`{"userName":"app"}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if string(body) != want {
+			t.Errorf("%s: expected %s, got %s", serverUrl, want, body)
+		}
+	}
+}
@@ -88,7 +88,7 @@ func setVaultDataModel(vault dvls.Vault, data *VaultDataSourceModel) {
 	*data = model
 }
 
-func lookupMapValue[K dvls.VaultSecurityLevel | dvls.VaultVisibility | dvls.VaultContentType](lookup map[K]string, value string) (K, error) {
+func lookupMapValue[K dvls.VaultSecurityLevel | dvls.VaultVisibility | dvls.VaultContentType | int](lookup map[K]string, value string) (K, error) {
 	for k, v := range lookup {
 		if v == value {
 			return k, nil
@@ -99,7 +99,7 @@ func lookupMapValue[K dvls.VaultSecurityLevel | dvls.VaultVisibility | dvls.Vaul
 	return zero, fmt.Errorf("value %s not found in lookup", value)
 }
 
-func listMapValues[K dvls.VaultSecurityLevel | dvls.VaultVisibility | dvls.VaultContentType](lookup map[K]string) string {
+func listMapValues[K dvls.VaultSecurityLevel | dvls.VaultVisibility | dvls.VaultContentType | int](lookup map[K]string) string {
 	values := slices.Sorted(maps.Values(lookup))
 
 	return fmt.Sprintf("[%s]", strings.Join(values, ", "))
@@ -24,7 +24,7 @@ func NewVaultDataSource() datasource.DataSource {
 
 // VaultDataSource defines the data source implementation.
 type VaultDataSource struct {
-	client *dvls.Client
+	client *dvlsClient
 }
 
 // VaultDataSourceModel describes the data source data model.
@@ -92,12 +92,12 @@ func (d *VaultDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*dvls.Client)
+	client, ok := req.ProviderData.(*dvlsClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *dvls.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.dvlsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -107,6 +107,11 @@ func (d *VaultDataSource) Configure(ctx context.Context, req datasource.Configur
 }
 
 func (d *VaultDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
 	var data *VaultDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -118,13 +123,13 @@ func (d *VaultDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	var err error
 
 	if !data.Id.IsNull() && !data.Id.IsUnknown() {
-		vault, err = d.client.Vaults.Get(data.Id.ValueString())
+		vault, err = d.client.Vaults.GetWithContext(ctx, data.Id.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("unable to read vault", err.Error())
+			addDvlsError(&resp.Diagnostics, "unable to read vault", err)
 			return
 		}
 	} else {
-		vault, err = d.client.Vaults.GetByName(data.Name.ValueString())
+		vault, err = d.client.Vaults.GetByNameWithContext(ctx, data.Name.ValueString())
 		if err != nil {
 			if errors.Is(err, dvls.ErrMultipleVaultsFound) {
 				resp.Diagnostics.AddError(
@@ -133,7 +138,7 @@ func (d *VaultDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				)
 				return
 			}
-			resp.Diagnostics.AddError("unable to read vault", err.Error())
+			addDvlsError(&resp.Diagnostics, "unable to read vault", err)
 			return
 		}
 	}
@@ -6,7 +6,6 @@ import (
 	"maps"
 	"slices"
 
-	"github.com/Devolutions/go-dvls"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -28,7 +27,7 @@ func NewVaultResource() resource.Resource {
 
 // VaultResource defines the resource implementation.
 type VaultResource struct {
-	client *dvls.Client
+	client *dvlsClient
 }
 
 // VaultResourceModel describes the resource data model.
@@ -94,12 +93,12 @@ func (r *VaultResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*dvls.Client)
+	client, ok := req.ProviderData.(*dvlsClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *dvls.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.dvlsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -109,6 +108,11 @@ func (r *VaultResource) Configure(ctx context.Context, req resource.ConfigureReq
 }
 
 func (r *VaultResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
 	var plan *VaultResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -122,9 +126,9 @@ func (r *VaultResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	createdVault, err := r.client.Vaults.New(requestVault)
+	createdVault, err := r.client.Vaults.NewWithContext(ctx, requestVault)
 	if err != nil {
-		resp.Diagnostics.AddError("unable to create vault", err.Error())
+		addDvlsError(&resp.Diagnostics, "unable to create vault", err)
 		return
 	}
 
@@ -134,6 +138,11 @@ func (r *VaultResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *VaultResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Keep the current state until the provider configuration is known.
+	if !r.client.configured() {
+		return
+	}
+
 	var state *VaultResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -141,13 +150,13 @@ func (r *VaultResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	vault, err := r.client.Vaults.Get(state.Id.ValueString())
+	vault, err := r.client.Vaults.GetWithContext(ctx, state.Id.ValueString())
 	if err != nil {
-		if dvls.IsNotFound(err) {
+		if isNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("unable to read vault", err.Error())
+		addDvlsError(&resp.Diagnostics, "unable to read vault", err)
 		return
 	}
 
@@ -157,6 +166,11 @@ func (r *VaultResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *VaultResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
 	var plan *VaultResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -170,9 +184,9 @@ func (r *VaultResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	updatedVault, err := r.client.Vaults.Update(requestVault)
+	updatedVault, err := r.client.Vaults.UpdateWithContext(ctx, requestVault)
 	if err != nil {
-		resp.Diagnostics.AddError("unable to update vault", err.Error())
+		addDvlsError(&resp.Diagnostics, "unable to update vault", err)
 		return
 	}
 
@@ -182,6 +196,11 @@ func (r *VaultResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *VaultResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
 	var state *VaultResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -189,13 +208,13 @@ func (r *VaultResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.Vaults.Delete(state.Id.ValueString())
+	err := r.client.Vaults.DeleteWithContext(ctx, state.Id.ValueString())
 	if err != nil {
-		if dvls.IsNotFound(err) {
+		if isNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("unable to delete vault", err.Error())
+		addDvlsError(&resp.Diagnostics, "unable to delete vault", err)
 		return
 	}
 }
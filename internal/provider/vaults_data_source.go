@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VaultsDataSource{}
+
+func NewVaultsDataSource() datasource.DataSource {
+	return &VaultsDataSource{}
+}
+
+// VaultsDataSource defines the data source implementation.
+type VaultsDataSource struct {
+	client *dvlsClient
+}
+
+// VaultsDataSourceModel describes the data source data model.
+type VaultsDataSourceModel struct {
+	NameRegex     types.String `tfsdk:"name_regex"`
+	Visibility    types.String `tfsdk:"visibility"`
+	SecurityLevel types.String `tfsdk:"security_level"`
+	ContentType   types.String `tfsdk:"content_type"`
+
+	Vaults []VaultDataSourceModel `tfsdk:"vaults"`
+}
+
+func (d *VaultsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vaults"
+}
+
+func (d *VaultsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Vaults data source",
+
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Only return the vaults whose name matches this regular expression.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"visibility": schema.StringAttribute{
+				Description: fmt.Sprintf("Only return the vaults with this visibility. Must be one of the following: %s", listMapValues(vaultVisibilities)),
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(slices.Collect(maps.Values(vaultVisibilities))...)},
+			},
+			"security_level": schema.StringAttribute{
+				Description: fmt.Sprintf("Only return the vaults with this security level. Must be one of the following: %s", listMapValues(vaultSecurityLevels)),
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(slices.Collect(maps.Values(vaultSecurityLevels))...)},
+			},
+			"content_type": schema.StringAttribute{
+				Description: fmt.Sprintf("Only return the vaults with this content type. Must be one of: %s", listMapValues(vaultContentTypes)),
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(slices.Collect(maps.Values(vaultContentTypes))...)},
+			},
+			"vaults": schema.ListNestedAttribute{
+				Description: "The vaults matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Vault ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Vault name",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Vault description",
+							Computed:    true,
+						},
+						"visibility": schema.StringAttribute{
+							Description: "Vault visibility",
+							Computed:    true,
+						},
+						"security_level": schema.StringAttribute{
+							Description: "Vault security level",
+							Computed:    true,
+						},
+						"content_type": schema.StringAttribute{
+							Description: "Vault content type",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VaultsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dvlsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.dvlsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VaultsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.client.configured() {
+		addProviderNotConfiguredError(&resp.Diagnostics)
+		return
+	}
+
+	var data *VaultsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "invalid name regular expression", err.Error())
+			return
+		}
+	}
+
+	vaults, err := d.client.Vaults.ListWithContext(ctx)
+	if err != nil {
+		addDvlsError(&resp.Diagnostics, "unable to list vaults", err)
+		return
+	}
+
+	data.Vaults = []VaultDataSourceModel{}
+
+	for _, vault := range vaults {
+		if nameRegex != nil && !nameRegex.MatchString(vault.Name) {
+			continue
+		}
+
+		if !data.Visibility.IsNull() && vaultVisibilities[vault.Visibility] != data.Visibility.ValueString() {
+			continue
+		}
+
+		if !data.SecurityLevel.IsNull() && vaultSecurityLevels[vault.SecurityLevel] != data.SecurityLevel.ValueString() {
+			continue
+		}
+
+		if !data.ContentType.IsNull() && vaultContentTypes[vault.ContentType] != data.ContentType.ValueString() {
+			continue
+		}
+
+		var model VaultDataSourceModel
+		setVaultDataModel(vault, &model)
+
+		data.Vaults = append(data.Vaults, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
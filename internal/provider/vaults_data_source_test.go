@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVaultsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultsDataSourceConfig("tf_test_vaults"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dvls_vaults.by_name_regex", "vaults.#", "2"),
+
+					resource.TestCheckResourceAttr("data.dvls_vaults.by_security_level", "vaults.#", "1"),
+					resource.TestCheckResourceAttrPair("data.dvls_vaults.by_security_level", "vaults.0.id", "dvls_vault.high", "id"),
+					resource.TestCheckResourceAttrPair("data.dvls_vaults.by_security_level", "vaults.0.name", "dvls_vault.high", "name"),
+					resource.TestCheckResourceAttrPair("data.dvls_vaults.by_security_level", "vaults.0.description", "dvls_vault.high", "description"),
+					resource.TestCheckResourceAttrPair("data.dvls_vaults.by_security_level", "vaults.0.visibility", "dvls_vault.high", "visibility"),
+					resource.TestCheckResourceAttrPair("data.dvls_vaults.by_security_level", "vaults.0.security_level", "dvls_vault.high", "security_level"),
+					resource.TestCheckResourceAttrPair("data.dvls_vaults.by_security_level", "vaults.0.content_type", "dvls_vault.high", "content_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVaultsDataSourceConfig(namePrefix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "dvls_vault" "standard" {
+  name           = "%[2]s_standard"
+  description    = "standard test vault for data source"
+  security_level = "standard"
+}
+
+resource "dvls_vault" "high" {
+  name           = "%[2]s_high"
+  description    = "high test vault for data source"
+  security_level = "high"
+}
+
+data "dvls_vaults" "by_name_regex" {
+  name_regex = "^%[2]s_"
+
+  depends_on = [dvls_vault.standard, dvls_vault.high]
+}
+
+data "dvls_vaults" "by_security_level" {
+  name_regex     = "^%[2]s_"
+  security_level = "high"
+
+  depends_on = [dvls_vault.standard, dvls_vault.high]
+}
+`, testAccProviderConfig(), namePrefix)
+}